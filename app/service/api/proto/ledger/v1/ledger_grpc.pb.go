@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ledger/v1/ledger.proto
+
+package ledgerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Ledger_Deposit_FullMethodName                = "/ledger.v1.Ledger/Deposit"
+	Ledger_Transfer_FullMethodName               = "/ledger.v1.Ledger/Transfer"
+	Ledger_Balance_FullMethodName                = "/ledger.v1.Ledger/Balance"
+	Ledger_TransfersHistory_FullMethodName       = "/ledger.v1.Ledger/TransfersHistory"
+	Ledger_StreamTransfersHistory_FullMethodName = "/ledger.v1.Ledger/StreamTransfersHistory"
+)
+
+// LedgerClient is the client API for Ledger service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Ledger mirrors the account endpoints of the HTTP API, backed by the same
+// use cases in domain/account.
+//
+// Domain errors are returned with the status code matching their HTTP
+// problem, the error as the status message and the problem code, e.g.
+// insufficient_funds, as the reason of a google.rpc.ErrorInfo detail:
+//
+//	INVALID_ARGUMENT     400 and 422, e.g. invalid_amount, invalid_currency
+//	NOT_FOUND            404, e.g. account_not_found
+//	FAILED_PRECONDITION  402 and 409, e.g. insufficient_funds, account_frozen,
+//	                     idempotency_key_reused
+//	INTERNAL             anything else, without its message
+type LedgerClient interface {
+	// Deposit money into an account.
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*TransactionCreated, error)
+	// Transfer money between two accounts.
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransactionCreated, error)
+	// Balance of an account in each of its currencies.
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	// A page of the entries of an account, newest first.
+	TransfersHistory(ctx context.Context, in *TransfersHistoryRequest, opts ...grpc.CallOption) (*TransfersHistoryResponse, error)
+	// Every entry of an account matching the request, newest first, without
+	// paging.
+	StreamTransfersHistory(ctx context.Context, in *TransfersHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Entry], error)
+}
+
+type ledgerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLedgerClient(cc grpc.ClientConnInterface) LedgerClient {
+	return &ledgerClient{cc}
+}
+
+func (c *ledgerClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*TransactionCreated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionCreated)
+	err := c.cc.Invoke(ctx, Ledger_Deposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransactionCreated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionCreated)
+	err := c.cc.Invoke(ctx, Ledger_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceResponse)
+	err := c.cc.Invoke(ctx, Ledger_Balance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) TransfersHistory(ctx context.Context, in *TransfersHistoryRequest, opts ...grpc.CallOption) (*TransfersHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransfersHistoryResponse)
+	err := c.cc.Invoke(ctx, Ledger_TransfersHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) StreamTransfersHistory(ctx context.Context, in *TransfersHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Entry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ledger_ServiceDesc.Streams[0], Ledger_StreamTransfersHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TransfersHistoryRequest, Entry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ledger_StreamTransfersHistoryClient = grpc.ServerStreamingClient[Entry]
+
+// LedgerServer is the server API for Ledger service.
+// All implementations must embed UnimplementedLedgerServer
+// for forward compatibility.
+//
+// Ledger mirrors the account endpoints of the HTTP API, backed by the same
+// use cases in domain/account.
+//
+// Domain errors are returned with the status code matching their HTTP
+// problem, the error as the status message and the problem code, e.g.
+// insufficient_funds, as the reason of a google.rpc.ErrorInfo detail:
+//
+//	INVALID_ARGUMENT     400 and 422, e.g. invalid_amount, invalid_currency
+//	NOT_FOUND            404, e.g. account_not_found
+//	FAILED_PRECONDITION  402 and 409, e.g. insufficient_funds, account_frozen,
+//	                     idempotency_key_reused
+//	INTERNAL             anything else, without its message
+type LedgerServer interface {
+	// Deposit money into an account.
+	Deposit(context.Context, *DepositRequest) (*TransactionCreated, error)
+	// Transfer money between two accounts.
+	Transfer(context.Context, *TransferRequest) (*TransactionCreated, error)
+	// Balance of an account in each of its currencies.
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	// A page of the entries of an account, newest first.
+	TransfersHistory(context.Context, *TransfersHistoryRequest) (*TransfersHistoryResponse, error)
+	// Every entry of an account matching the request, newest first, without
+	// paging.
+	StreamTransfersHistory(*TransfersHistoryRequest, grpc.ServerStreamingServer[Entry]) error
+	mustEmbedUnimplementedLedgerServer()
+}
+
+// UnimplementedLedgerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServer struct{}
+
+func (UnimplementedLedgerServer) Deposit(context.Context, *DepositRequest) (*TransactionCreated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedLedgerServer) Transfer(context.Context, *TransferRequest) (*TransactionCreated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedLedgerServer) Balance(context.Context, *BalanceRequest) (*BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (UnimplementedLedgerServer) TransfersHistory(context.Context, *TransfersHistoryRequest) (*TransfersHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransfersHistory not implemented")
+}
+func (UnimplementedLedgerServer) StreamTransfersHistory(*TransfersHistoryRequest, grpc.ServerStreamingServer[Entry]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTransfersHistory not implemented")
+}
+func (UnimplementedLedgerServer) mustEmbedUnimplementedLedgerServer() {}
+func (UnimplementedLedgerServer) testEmbeddedByValue()                {}
+
+// UnsafeLedgerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServer will
+// result in compilation errors.
+type UnsafeLedgerServer interface {
+	mustEmbedUnimplementedLedgerServer()
+}
+
+func RegisterLedgerServer(s grpc.ServiceRegistrar, srv LedgerServer) {
+	// If the following call pancis, it indicates UnimplementedLedgerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Ledger_ServiceDesc, srv)
+}
+
+func _Ledger_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_Balance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_TransfersHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransfersHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).TransfersHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_TransfersHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).TransfersHistory(ctx, req.(*TransfersHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_StreamTransfersHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransfersHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServer).StreamTransfersHistory(m, &grpc.GenericServerStream[TransfersHistoryRequest, Entry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ledger_StreamTransfersHistoryServer = grpc.ServerStreamingServer[Entry]
+
+// Ledger_ServiceDesc is the grpc.ServiceDesc for Ledger service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ledger_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.v1.Ledger",
+	HandlerType: (*LedgerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _Ledger_Deposit_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Ledger_Transfer_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _Ledger_Balance_Handler,
+		},
+		{
+			MethodName: "TransfersHistory",
+			Handler:    _Ledger_TransfersHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTransfersHistory",
+			Handler:       _Ledger_StreamTransfersHistory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ledger/v1/ledger.proto",
+}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	ledgerv1 "github.com/julioc98/ledger/app/service/api/proto/ledger/v1"
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements the gRPC Ledger service on the use cases behind the
+// account endpoints of the HTTP API.
+type Server struct {
+	ledgerv1.UnimplementedLedgerServer
+
+	deposit  v1.DepositUseCase
+	transfer v1.TransferUseCase
+	balance  v1.BalanceUseCase
+	history  v1.TransfersHistoryUseCase
+}
+
+// NewServer creates a new Server.
+func NewServer(deposit v1.DepositUseCase, transfer v1.TransferUseCase, balance v1.BalanceUseCase, history v1.TransfersHistoryUseCase) *Server {
+	return &Server{
+		deposit:  deposit,
+		transfer: transfer,
+		balance:  balance,
+		history:  history,
+	}
+}
+
+// Register registers the Ledger service of s on gs.
+func (s *Server) Register(gs *grpc.Server) {
+	ledgerv1.RegisterLedgerServer(gs, s)
+}
+
+func (s *Server) Deposit(ctx context.Context, req *ledgerv1.DepositRequest) (*ledgerv1.TransactionCreated, error) {
+	input := account.DepositInput{
+		Account:        req.GetAccount(),
+		Amount:         req.GetAmount(),
+		Currency:       currencyOrDefault(req.GetCurrency()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	}
+	transactionID, err := s.deposit.Deposit(ctx, input)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &ledgerv1.TransactionCreated{TransactionId: transactionID}, nil
+}
+
+func (s *Server) Transfer(ctx context.Context, req *ledgerv1.TransferRequest) (*ledgerv1.TransactionCreated, error) {
+	input := account.TransferInput{
+		FromAccount:    req.GetFromAccount(),
+		ToAccount:      req.GetToAccount(),
+		Amount:         req.GetAmount(),
+		Currency:       currencyOrDefault(req.GetCurrency()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	}
+	transactionID, err := s.transfer.Transfer(ctx, input)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &ledgerv1.TransactionCreated{TransactionId: transactionID}, nil
+}
+
+func (s *Server) Balance(ctx context.Context, req *ledgerv1.BalanceRequest) (*ledgerv1.BalanceResponse, error) {
+	input := account.BalanceInput{
+		Account:         req.GetAccount(),
+		AsOf:            timeOrNil(req.GetAsOf()),
+		IncludeChildren: req.GetIncludeChildren(),
+	}
+	balances, err := s.balance.Balance(ctx, input)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &ledgerv1.BalanceResponse{
+		Balances: make([]*ledgerv1.Balance, 0, len(balances)),
+	}
+	for _, b := range balances {
+		resp.Balances = append(resp.Balances, &ledgerv1.Balance{
+			Currency:  b.Currency.Code,
+			Exponent:  int32(b.Currency.Exponent),
+			Amount:    b.Amount,
+			Available: b.Available,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *Server) TransfersHistory(ctx context.Context, req *ledgerv1.TransfersHistoryRequest) (*ledgerv1.TransfersHistoryResponse, error) {
+	transfers, err := s.history.TransfersHistory(ctx, transfersHistoryInput(req))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &ledgerv1.TransfersHistoryResponse{
+		Entries:    newEntries(transfers.Entries),
+		NextCursor: transfers.NextCursor,
+	}, nil
+}
+
+// StreamTransfersHistory sends the entries matching the request page by page,
+// following the cursors from the one of the request until the last page.
+func (s *Server) StreamTransfersHistory(req *ledgerv1.TransfersHistoryRequest, stream ledgerv1.Ledger_StreamTransfersHistoryServer) error {
+	input := transfersHistoryInput(req)
+	input.Limit = account.MaxHistoryLimit
+
+	for {
+		transfers, err := s.history.TransfersHistory(stream.Context(), input)
+		if err != nil {
+			return statusError(err)
+		}
+
+		for _, e := range newEntries(transfers.Entries) {
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		}
+
+		if transfers.NextCursor == "" {
+			return nil
+		}
+		input.Cursor = transfers.NextCursor
+	}
+}
+
+func transfersHistoryInput(req *ledgerv1.TransfersHistoryRequest) account.TransfersHistoryInput {
+	return account.TransfersHistoryInput{
+		Account:   req.GetAccount(),
+		From:      timeOrNil(req.GetFrom()),
+		To:        timeOrNil(req.GetTo()),
+		Direction: entities.Direction(req.GetDirection()),
+		MinAmount: req.GetMinAmount(),
+		MaxAmount: req.GetMaxAmount(),
+		Cursor:    req.GetCursor(),
+		Limit:     int(req.GetLimit()),
+	}
+}
+
+func newEntries(entries []entities.Entry) []*ledgerv1.Entry {
+	resp := make([]*ledgerv1.Entry, 0, len(entries))
+	for _, e := range entries {
+		entry := &ledgerv1.Entry{
+			Id:            e.ID,
+			TransactionId: e.TransactionID,
+			Account:       e.Account,
+			Direction:     string(e.Direction),
+			Amount:        e.Amount,
+			Currency:      e.Currency.Code,
+			Status:        string(e.Status),
+			ReversalOf:    e.ReversalOf,
+		}
+		if e.CreatedAt != nil {
+			entry.CreatedAt = timestamppb.New(*e.CreatedAt)
+		}
+
+		resp = append(resp, entry)
+	}
+
+	return resp
+}
+
+// timeOrNil converts an optional timestamp.
+func timeOrNil(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
+
+// currencyOrDefault returns the currency code of a request, or the default
+// one when it names none.
+func currencyOrDefault(code string) string {
+	if code == "" {
+		return entities.DefaultCurrencyCode
+	}
+
+	return code
+}
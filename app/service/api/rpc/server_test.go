@@ -0,0 +1,404 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	ledgerv1 "github.com/julioc98/ledger/app/service/api/proto/ledger/v1"
+	"github.com/julioc98/ledger/app/service/api/rpc"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestClient serves s over an in-memory listener and returns a client of
+// it.
+func newTestClient(t *testing.T, s *rpc.Server) ledgerv1.LedgerClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	s.Register(gs)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return ledgerv1.NewLedgerClient(conn)
+}
+
+// assertStatus asserts err is a status with the code, and the problem code
+// as its ErrorInfo reason unless it is empty.
+func assertStatus(t *testing.T, err error, code codes.Code, problem string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a status: %v", err)
+	assert.Equal(t, code, st.Code())
+
+	var reasons []string
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			assert.Equal(t, "ledger", info.Domain)
+			reasons = append(reasons, info.Reason)
+		}
+	}
+
+	if problem == "" {
+		assert.Empty(t, reasons)
+	} else {
+		assert.Equal(t, []string{problem}, reasons)
+	}
+}
+
+func TestServerDeposit(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         *ledgerv1.DepositRequest
+		mockError       error
+		expectedInput   account.DepositInput
+		expectedCode    codes.Code
+		expectedProblem string
+		expectedMessage string
+	}{
+		{
+			name:          "Valid request",
+			request:       &ledgerv1.DepositRequest{Account: "123", Amount: 100, IdempotencyKey: "key-1"},
+			expectedInput: account.DepositInput{Account: "123", Amount: 100, Currency: "USD", IdempotencyKey: "key-1"},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "Explicit currency",
+			request:       &ledgerv1.DepositRequest{Account: "123", Amount: 100, Currency: "EUR"},
+			expectedInput: account.DepositInput{Account: "123", Amount: 100, Currency: "EUR"},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:            "Invalid amount",
+			request:         &ledgerv1.DepositRequest{Account: "123", Amount: -5},
+			mockError:       domain.ErrInvalidAmount,
+			expectedInput:   account.DepositInput{Account: "123", Amount: -5, Currency: "USD"},
+			expectedCode:    codes.InvalidArgument,
+			expectedProblem: "invalid_amount",
+			expectedMessage: domain.ErrInvalidAmount.Error(),
+		},
+		{
+			name:            "Idempotency key reused",
+			request:         &ledgerv1.DepositRequest{Account: "123", Amount: 100, IdempotencyKey: "key-1"},
+			mockError:       domain.ErrIdempotencyKeyReused,
+			expectedInput:   account.DepositInput{Account: "123", Amount: 100, Currency: "USD", IdempotencyKey: "key-1"},
+			expectedCode:    codes.FailedPrecondition,
+			expectedProblem: "idempotency_key_reused",
+			expectedMessage: domain.ErrIdempotencyKeyReused.Error(),
+		},
+		{
+			name:            "Internal error",
+			request:         &ledgerv1.DepositRequest{Account: "123", Amount: 100},
+			mockError:       errors.New("connection refused"),
+			expectedInput:   account.DepositInput{Account: "123", Amount: 100, Currency: "USD"},
+			expectedCode:    codes.Internal,
+			expectedMessage: "Internal Server Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mocks.DepositUseCaseMock{
+				DepositFunc: func(ctx context.Context, input account.DepositInput) (int64, error) {
+					assert.Equal(t, tt.expectedInput, input)
+					if tt.mockError != nil {
+						return 0, tt.mockError
+					}
+					return 1, nil
+				},
+			}
+			client := newTestClient(t, rpc.NewServer(uc, nil, nil, nil))
+
+			resp, err := client.Deposit(context.Background(), tt.request)
+
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, int64(1), resp.GetTransactionId())
+			} else {
+				assertStatus(t, err, tt.expectedCode, tt.expectedProblem)
+				assert.Equal(t, tt.expectedMessage, status.Convert(err).Message())
+			}
+			assert.Len(t, uc.DepositCalls(), 1)
+		})
+	}
+}
+
+func TestServerTransfer(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockError       error
+		expectedCode    codes.Code
+		expectedProblem string
+	}{
+		{
+			name:         "Valid request",
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "Insufficient funds",
+			mockError:       domain.ErrInsufficientFunds,
+			expectedCode:    codes.FailedPrecondition,
+			expectedProblem: "insufficient_funds",
+		},
+		{
+			name:            "Account not found",
+			mockError:       domain.ErrAccountNotFound,
+			expectedCode:    codes.NotFound,
+			expectedProblem: "account_not_found",
+		},
+		{
+			name:            "Self transfer",
+			mockError:       domain.ErrSelfTransfer,
+			expectedCode:    codes.InvalidArgument,
+			expectedProblem: "self_transfer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mocks.TransferUseCaseMock{
+				TransferFunc: func(ctx context.Context, input account.TransferInput) (int64, error) {
+					assert.Equal(t, account.TransferInput{FromAccount: "123", ToAccount: "456", Amount: 100, Currency: "USD"}, input)
+					if tt.mockError != nil {
+						return 0, tt.mockError
+					}
+					return 7, nil
+				},
+			}
+			client := newTestClient(t, rpc.NewServer(nil, uc, nil, nil))
+
+			resp, err := client.Transfer(context.Background(), &ledgerv1.TransferRequest{FromAccount: "123", ToAccount: "456", Amount: 100})
+
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, int64(7), resp.GetTransactionId())
+			} else {
+				assertStatus(t, err, tt.expectedCode, tt.expectedProblem)
+			}
+		})
+	}
+}
+
+func TestServerBalance(t *testing.T) {
+	asOf := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Balance at a point in time", func(t *testing.T) {
+		uc := &mocks.BalanceUseCaseMock{
+			BalanceFunc: func(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error) {
+				assert.Equal(t, "123", input.Account)
+				assert.True(t, input.IncludeChildren)
+				require.NotNil(t, input.AsOf)
+				assert.True(t, asOf.Equal(*input.AsOf))
+				return []entities.Balance{
+					{Currency: entities.Currency{Code: "USD", Exponent: 2}, Amount: 1000, Available: 800},
+					{Currency: entities.Currency{Code: "JPY", Exponent: 0}, Amount: 50, Available: 50},
+				}, nil
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, uc, nil))
+
+		resp, err := client.Balance(context.Background(), &ledgerv1.BalanceRequest{
+			Account:         "123",
+			AsOf:            timestamppb.New(asOf),
+			IncludeChildren: true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.GetBalances(), 2)
+		assert.Equal(t, "USD", resp.GetBalances()[0].GetCurrency())
+		assert.Equal(t, int32(2), resp.GetBalances()[0].GetExponent())
+		assert.Equal(t, int64(1000), resp.GetBalances()[0].GetAmount())
+		assert.Equal(t, int64(800), resp.GetBalances()[0].GetAvailable())
+		assert.Equal(t, "JPY", resp.GetBalances()[1].GetCurrency())
+		assert.Equal(t, int32(0), resp.GetBalances()[1].GetExponent())
+	})
+
+	t.Run("Current balance", func(t *testing.T) {
+		uc := &mocks.BalanceUseCaseMock{
+			BalanceFunc: func(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error) {
+				assert.Nil(t, input.AsOf)
+				return []entities.Balance{}, nil
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, uc, nil))
+
+		resp, err := client.Balance(context.Background(), &ledgerv1.BalanceRequest{Account: "123"})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetBalances())
+	})
+
+	t.Run("Account not found", func(t *testing.T) {
+		uc := &mocks.BalanceUseCaseMock{
+			BalanceFunc: func(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error) {
+				return nil, domain.ErrAccountNotFound
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, uc, nil))
+
+		_, err := client.Balance(context.Background(), &ledgerv1.BalanceRequest{Account: "999"})
+
+		assertStatus(t, err, codes.NotFound, "account_not_found")
+	})
+}
+
+func TestServerTransfersHistory(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Page", func(t *testing.T) {
+		uc := &mocks.TransfersHistoryUseCaseMock{
+			TransfersHistoryFunc: func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
+				assert.Equal(t, "123", input.Account)
+				assert.Equal(t, 10, input.Limit)
+				assert.Equal(t, "abc", input.Cursor)
+				assert.Equal(t, entities.Credit, input.Direction)
+				assert.Equal(t, int64(5), input.MinAmount)
+				assert.Equal(t, int64(500), input.MaxAmount)
+				require.NotNil(t, input.From)
+				assert.True(t, from.Equal(*input.From))
+				assert.Nil(t, input.To)
+				return &account.TransfersHistoryOutput{
+					Entries: []entities.Entry{{
+						ID:            3,
+						TransactionID: 2,
+						Account:       "123",
+						Direction:     entities.Credit,
+						Amount:        100,
+						Currency:      entities.Currency{Code: "USD", Exponent: 2},
+						Status:        entities.TransactionPosted,
+						ReversalOf:    1,
+						CreatedAt:     &createdAt,
+					}},
+					NextCursor: "def",
+				}, nil
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, nil, uc))
+
+		resp, err := client.TransfersHistory(context.Background(), &ledgerv1.TransfersHistoryRequest{
+			Account:   "123",
+			Limit:     10,
+			Cursor:    "abc",
+			From:      timestamppb.New(from),
+			Direction: "credit",
+			MinAmount: 5,
+			MaxAmount: 500,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "def", resp.GetNextCursor())
+		require.Len(t, resp.GetEntries(), 1)
+		e := resp.GetEntries()[0]
+		assert.Equal(t, int64(3), e.GetId())
+		assert.Equal(t, int64(2), e.GetTransactionId())
+		assert.Equal(t, "123", e.GetAccount())
+		assert.Equal(t, "credit", e.GetDirection())
+		assert.Equal(t, int64(100), e.GetAmount())
+		assert.Equal(t, "USD", e.GetCurrency())
+		assert.Equal(t, "posted", e.GetStatus())
+		assert.Equal(t, int64(1), e.GetReversalOf())
+		assert.True(t, createdAt.Equal(e.GetCreatedAt().AsTime()))
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		uc := &mocks.TransfersHistoryUseCaseMock{
+			TransfersHistoryFunc: func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
+				return nil, domain.ErrInvalidCursor
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, nil, uc))
+
+		_, err := client.TransfersHistory(context.Background(), &ledgerv1.TransfersHistoryRequest{Account: "123", Cursor: "???"})
+
+		assertStatus(t, err, codes.InvalidArgument, "invalid_cursor")
+	})
+}
+
+func TestServerStreamTransfersHistory(t *testing.T) {
+	pages := map[string]*account.TransfersHistoryOutput{
+		"":       {Entries: []entities.Entry{{ID: 5}, {ID: 4}}, NextCursor: "page-2"},
+		"page-2": {Entries: []entities.Entry{{ID: 3}, {ID: 2}}, NextCursor: "page-3"},
+		"page-3": {Entries: []entities.Entry{{ID: 1}}},
+	}
+
+	t.Run("Every page", func(t *testing.T) {
+		uc := &mocks.TransfersHistoryUseCaseMock{
+			TransfersHistoryFunc: func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
+				assert.Equal(t, "123", input.Account)
+				assert.Equal(t, account.MaxHistoryLimit, input.Limit)
+				return pages[input.Cursor], nil
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, nil, uc))
+
+		stream, err := client.StreamTransfersHistory(context.Background(), &ledgerv1.TransfersHistoryRequest{Account: "123", Limit: 2})
+		require.NoError(t, err)
+
+		var ids []int64
+		for {
+			e, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			ids = append(ids, e.GetId())
+		}
+
+		assert.Equal(t, []int64{5, 4, 3, 2, 1}, ids)
+		assert.Len(t, uc.TransfersHistoryCalls(), 3)
+	})
+
+	t.Run("Failure after the first page", func(t *testing.T) {
+		uc := &mocks.TransfersHistoryUseCaseMock{
+			TransfersHistoryFunc: func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
+				if input.Cursor != "" {
+					return nil, domain.ErrAccountNotFound
+				}
+				return pages[input.Cursor], nil
+			},
+		}
+		client := newTestClient(t, rpc.NewServer(nil, nil, nil, uc))
+
+		stream, err := client.StreamTransfersHistory(context.Background(), &ledgerv1.TransfersHistoryRequest{Account: "123"})
+		require.NoError(t, err)
+
+		var ids []int64
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				assertStatus(t, err, codes.NotFound, "account_not_found")
+				break
+			}
+			ids = append(ids, e.GetId())
+		}
+
+		assert.Equal(t, []int64{5, 4}, ids)
+	})
+}
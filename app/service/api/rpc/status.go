@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"net/http"
+
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo details of the statuses.
+const errorDomain = "ledger"
+
+// statusCodes maps the HTTP status of the problems reporting domain errors
+// to the gRPC status code of the same errors.
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusPaymentRequired:     codes.FailedPrecondition,
+	http.StatusConflict:            codes.FailedPrecondition,
+}
+
+// statusError converts err to a gRPC status error carrying the problem code
+// of err as the reason of an ErrorInfo detail. Errors unknown to the mapping
+// are reported as internal errors without leaking their message.
+func statusError(err error) error {
+	httpStatus, problem, ok := v1.ProblemOf(err)
+	if !ok {
+		return status.Error(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+
+	code, ok := statusCodes[httpStatus]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	st, detailErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: problem,
+		Domain: errorDomain,
+	})
+	if detailErr != nil {
+		return status.Error(code, err.Error())
+	}
+
+	return st.Err()
+}
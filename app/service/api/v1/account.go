@@ -3,21 +3,34 @@ package v1
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
 )
 
+// IdempotencyKeyHeader is the request header carrying the idempotency key of
+// deposits, transfers and withdrawals.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type CreateDepositRequest struct {
 	Amount int64 `json:"amount"`
+	// ISO 4217 currency code, defaults to USD
+	Currency string `json:"currency"`
+}
+
+// TransactionCreatedResponse is returned when money movements are booked.
+type TransactionCreatedResponse struct {
+	TransactionID int64 `json:"transaction_id"`
 }
 
 //go:generate moq -stub -pkg mocks -out mocks/deposit_uc.go . DepositUseCase
 type DepositUseCase interface {
-	Deposit(ctx context.Context, input account.DepositInput) error
+	Deposit(ctx context.Context, input account.DepositInput) (int64, error)
 }
 
 // CreateDepositHandler godoc
@@ -27,41 +40,51 @@ type DepositUseCase interface {
 // @Accept       json
 // @Produce      json
 // @Param        account path string true "Account ID"
+// @Param        Idempotency-Key header string false "Key that makes retrying the request safe"
 // @Param request body CreateDepositRequest true "Deposit details"
-// @Success      201 "Deposit created successfully"
-// @Failure      400 "Invalid request payload"
-// @Failure      500 "Internal Server Error"
+// @Success      201 {object} TransactionCreatedResponse "Deposit created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      409 {object} Problem "Idempotency key already used with a different request"
+// @Failure      422 {object} Problem "Invalid amount or account"
+// @Failure      500 {object} Problem "Internal Server Error"
 // @Router       /api/v1/account/{account}/deposit [post]
 func CreateDepositHandler(uc DepositUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		accountP := chi.URLParam(r, "account")
 		var req CreateDepositRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
 			return
 		}
 
 		input := account.DepositInput{
-			Account: accountP,
-			Amount:  req.Amount,
+			Account:        accountP,
+			Amount:         req.Amount,
+			Currency:       currencyOrDefault(req.Currency),
+			IdempotencyKey: r.Header.Get(IdempotencyKeyHeader),
 		}
-		if err := uc.Deposit(r.Context(), input); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		transactionID, err := uc.Deposit(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
 			return
 		}
 
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(TransactionCreatedResponse{transactionID})
 	}
 }
 
 type CreateTransferRequest struct {
 	To     string `json:"to"`
 	Amount int64  `json:"amount"`
+	// ISO 4217 currency code, defaults to USD
+	Currency string `json:"currency"`
 }
 
 //go:generate moq -stub -pkg mocks -out mocks/transfer_uc.go . TransferUseCase
 type TransferUseCase interface {
-	Transfer(ctx context.Context, input account.TransferInput) error
+	Transfer(ctx context.Context, input account.TransferInput) (int64, error)
 }
 
 // CreateTransferHandler godoc
@@ -71,90 +94,273 @@ type TransferUseCase interface {
 // @Accept       json
 // @Produce      json
 // @Param        account path string true "Account ID"
+// @Param        Idempotency-Key header string false "Key that makes retrying the request safe"
 // @Param request body CreateTransferRequest true "Transfer details"
-// @Success      201 "Transfer created successfully"
-// @Failure      400 "Invalid request payload"
-// @Failure      402 "Insufficient funds"
-// @Failure      500 "Internal Server Error"
+// @Success      201 {object} TransactionCreatedResponse "Transfer created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      402 {object} Problem "Insufficient funds"
+// @Failure      409 {object} Problem "Idempotency key already used with a different request"
+// @Failure      422 {object} Problem "Invalid amount or account"
+// @Failure      500 {object} Problem "Internal Server Error"
 // @Router       /api/v1/account/{account}/transfers [post]
 func CreateTransferHandler(uc TransferUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		accountP := chi.URLParam(r, "account")
 		var req CreateTransferRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
 			return
 		}
 
 		input := account.TransferInput{
-			FromAccount: accountP,
-			ToAccount:   req.To,
-			Amount:      req.Amount,
+			FromAccount:    accountP,
+			ToAccount:      req.To,
+			Amount:         req.Amount,
+			Currency:       currencyOrDefault(req.Currency),
+			IdempotencyKey: r.Header.Get(IdempotencyKeyHeader),
 		}
-		if err := uc.Transfer(r.Context(), input); err != nil {
-			if errors.Is(err, domain.ErrInsufficientFunds) {
-				http.Error(w, err.Error(), http.StatusPaymentRequired)
-				return
-			}
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		transactionID, err := uc.Transfer(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(TransactionCreatedResponse{transactionID})
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/pending_transfer_uc.go . PendingTransferUseCase
+type PendingTransferUseCase interface {
+	PendingTransfer(ctx context.Context, input account.TransferInput) (int64, error)
+}
+
+// CreatePendingTransferHandler godoc
+// @Summary      Create a pending transfer
+// @Description  Reserve the funds of a transfer from the specified account in a pending transaction, to be posted or voided later
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Param        Idempotency-Key header string false "Key that makes retrying the request safe"
+// @Param request body CreateTransferRequest true "Transfer details"
+// @Success      201 {object} TransactionCreatedResponse "Pending transfer created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      402 {object} Problem "Insufficient funds"
+// @Failure      409 {object} Problem "Idempotency key already used with a different request"
+// @Failure      422 {object} Problem "Invalid amount or account"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/account/{account}/pending-transfers [post]
+func CreatePendingTransferHandler(uc PendingTransferUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateTransferRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.TransferInput{
+			FromAccount:    chi.URLParam(r, "account"),
+			ToAccount:      req.To,
+			Amount:         req.Amount,
+			Currency:       currencyOrDefault(req.Currency),
+			IdempotencyKey: r.Header.Get(IdempotencyKeyHeader),
+		}
+		transactionID, err := uc.PendingTransfer(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(TransactionCreatedResponse{transactionID})
+	}
+}
+
+type CreateWithdrawalRequest struct {
+	Amount int64 `json:"amount"`
+	// ISO 4217 currency code, defaults to USD
+	Currency string `json:"currency"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/withdraw_uc.go . WithdrawUseCase
+type WithdrawUseCase interface {
+	Withdraw(ctx context.Context, input account.WithdrawInput) (int64, error)
+}
+
+// CreateWithdrawalHandler godoc
+// @Summary      Create a withdrawal
+// @Description  Withdraw funds from the specified account
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Param        Idempotency-Key header string false "Key that makes retrying the request safe"
+// @Param request body CreateWithdrawalRequest true "Withdrawal details"
+// @Success      201 {object} TransactionCreatedResponse "Withdrawal created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      402 {object} Problem "Insufficient funds"
+// @Failure      409 {object} Problem "Idempotency key already used with a different request"
+// @Failure      422 {object} Problem "Invalid amount or account"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/account/{account}/withdrawals [post]
+func CreateWithdrawalHandler(uc WithdrawUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		accountP := chi.URLParam(r, "account")
+		var req CreateWithdrawalRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.WithdrawInput{
+			Account:        accountP,
+			Amount:         req.Amount,
+			Currency:       currencyOrDefault(req.Currency),
+			IdempotencyKey: r.Header.Get(IdempotencyKeyHeader),
+		}
+		transactionID, err := uc.Withdraw(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
 			return
 		}
 
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(TransactionCreatedResponse{transactionID})
+	}
+}
+
+// currencyOrDefault keeps requests written before multi-currency support
+// booking in the default currency.
+func currencyOrDefault(code string) string {
+	if code == "" {
+		return entities.DefaultCurrencyCode
 	}
+
+	return code
+}
+
+// CurrencyBalance is the balance of an account in a single currency.
+type CurrencyBalance struct {
+	// ISO 4217 currency code
+	Currency string `json:"currency"`
+	// Number of digits of the minor unit
+	Exponent int `json:"exponent"`
+	// Posted balance in minor units
+	Amount int64 `json:"amount"`
+	// Posted balance minus the funds reserved by active holds and pending
+	// transfers
+	Available int64 `json:"available"`
+}
+
+type BalanceResponse struct {
+	Balances []CurrencyBalance `json:"balances"`
 }
 
 //go:generate moq -stub -pkg mocks -out mocks/balance_uc.go . BalanceUseCase
 type BalanceUseCase interface {
-	Balance(ctx context.Context, account string) (int64, error)
+	Balance(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error)
 }
 
 // GetBalanceHandler godoc
 // @Summary      Get account balance
-// @Description  Get the balance of the specified account
+// @Description  Get the balance of the specified account, now or at a point in time, optionally rolling up its descendants
 // @Tags         accounts
 // @Accept       json
 // @Produce      json
 // @Param        account path string true "Account ID"
-// @Success      200 "Balance retrieved successfully"
-// @Failure      500 "Internal Server Error"
+// @Param        as_of query string false "RFC 3339 time to compute the balance at, defaults to now"
+// @Param        include_children query bool false "Add up the balances of the account's whole subtree" default(false)
+// @Success      200 {object} BalanceResponse "Balance retrieved successfully"
+// @Failure      400 {object} Problem "Invalid as_of time or include_children flag"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      500 {object} Problem "Internal Server Error"
 // @Router       /api/v1/account/{account}/balance [get]
+// @Router       /api/v1/accounts/{account}/balance [get]
 func GetBalanceHandler(uc BalanceUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		account := chi.URLParam(r, "account")
-		balance, err := uc.Balance(r.Context(), account)
+		q := r.URL.Query()
+		asOf, err := queryTime(q.Get("as_of"))
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid as_of: "+err.Error())
 			return
 		}
 
+		var includeChildren bool
+		if v := q.Get("include_children"); v != "" {
+			includeChildren, err = strconv.ParseBool(v)
+			if err != nil {
+				writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid include_children: "+err.Error())
+				return
+			}
+		}
+
+		input := account.BalanceInput{
+			Account:         chi.URLParam(r, "account"),
+			AsOf:            asOf,
+			IncludeChildren: includeChildren,
+		}
+		balances, err := uc.Balance(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := BalanceResponse{
+			Balances: make([]CurrencyBalance, 0, len(balances)),
+		}
+		for _, b := range balances {
+			resp.Balances = append(resp.Balances, CurrencyBalance{
+				Currency:  b.Currency.Code,
+				Exponent:  b.Currency.Exponent,
+				Amount:    b.Amount,
+				Available: b.Available,
+			})
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(map[string]int64{"balance": balance})
+		json.NewEncoder(w).Encode(resp)
 	}
 }
 
 //go:generate moq -stub -pkg mocks -out mocks/transfers_history_uc.go . TransfersHistoryUseCase
 type TransfersHistoryUseCase interface {
-	TransfersHistory(ctx context.Context, account string) (*account.TransfersHistoryOutput, error)
+	TransfersHistory(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error)
 }
 
 // GetTransfersHistoryHandler godoc
 // @Summary      Get transfers history
-// @Description  Get the transfer history of the specified account
+// @Description  Get a page of the transfer history of the specified account, newest first
 // @Tags         accounts
 // @Accept       json
 // @Produce      json
 // @Param        account path string true "Account ID"
-// @Success      200 "Transfers history retrieved successfully"
-// @Failure      500 "Internal Server Error"
+// @Param        limit query int false "Page size, up to 500" default(50)
+// @Param        cursor query string false "next_cursor of the previous page"
+// @Param        from query string false "Only entries created at or after this RFC 3339 time"
+// @Param        to query string false "Only entries created before this RFC 3339 time"
+// @Param        direction query string false "Only debits or only credits" Enums(debit, credit)
+// @Param        min_amount query int false "Only entries of at least this amount"
+// @Param        max_amount query int false "Only entries of at most this amount"
+// @Success      200 {object} account.TransfersHistoryOutput "Transfers history retrieved successfully"
+// @Failure      400 {object} Problem "Invalid query parameters"
+// @Failure      500 {object} Problem "Internal Server Error"
 // @Router       /api/v1/account/{account}/transfers [get]
 func GetTransfersHistoryHandler(uc TransfersHistoryUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		account := chi.URLParam(r, "account")
-		transfers, err := uc.TransfersHistory(r.Context(), account)
+		input, err := transfersHistoryInput(r)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		transfers, err := uc.TransfersHistory(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
 			return
 		}
 
@@ -163,3 +369,57 @@ func GetTransfersHistoryHandler(uc TransfersHistoryUseCase) http.HandlerFunc {
 		json.NewEncoder(w).Encode(transfers)
 	}
 }
+
+// transfersHistoryInput reads the page and filters of a transfers history
+// request from its query string.
+func transfersHistoryInput(r *http.Request) (account.TransfersHistoryInput, error) {
+	q := r.URL.Query()
+	input := account.TransfersHistoryInput{
+		Account:   chi.URLParam(r, "account"),
+		Direction: entities.Direction(q.Get("direction")),
+		Cursor:    q.Get("cursor"),
+	}
+
+	var err error
+	if v := q.Get("limit"); v != "" {
+		if input.Limit, err = strconv.Atoi(v); err != nil {
+			return input, fmt.Errorf("invalid limit: %w", err)
+		}
+	}
+
+	if input.From, err = queryTime(q.Get("from")); err != nil {
+		return input, fmt.Errorf("invalid from: %w", err)
+	}
+
+	if input.To, err = queryTime(q.Get("to")); err != nil {
+		return input, fmt.Errorf("invalid to: %w", err)
+	}
+
+	if v := q.Get("min_amount"); v != "" {
+		if input.MinAmount, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return input, fmt.Errorf("invalid min_amount: %w", err)
+		}
+	}
+
+	if v := q.Get("max_amount"); v != "" {
+		if input.MaxAmount, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return input, fmt.Errorf("invalid max_amount: %w", err)
+		}
+	}
+
+	return input, nil
+}
+
+// queryTime parses an optional RFC 3339 query parameter.
+func queryTime(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
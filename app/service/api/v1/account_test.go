@@ -14,6 +14,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	v1 "github.com/julioc98/ledger/app/service/api/v1"
 	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/account"
 	"github.com/julioc98/ledger/domain/entities"
 	"github.com/stretchr/testify/assert"
@@ -30,20 +31,54 @@ func NewTestHTTPResponseWriter() http.ResponseWriter {
 
 func TestCreateDepositHandler(t *testing.T) {
 	tests := []struct {
-		name          string
-		requestBody   string
-		expectedCode  int
-		mockError     error
-		depositCalled bool
-		invalidInput  bool
+		name           string
+		requestBody    string
+		expectedCode   int
+		expectedBody   string
+		idempotencyKey string
+		mockError      error
+		depositCalled  bool
+		invalidInput   bool
 	}{
 		{
 			name:          "Valid request",
 			requestBody:   `{"amount": 100}`,
 			expectedCode:  http.StatusCreated,
+			expectedBody:  `{"transaction_id":1}`,
 			mockError:     nil,
 			depositCalled: true,
 		},
+		{
+			name:           "Replayed idempotency key",
+			requestBody:    `{"amount": 100}`,
+			expectedCode:   http.StatusCreated,
+			expectedBody:   `{"transaction_id":1}`,
+			idempotencyKey: "key-1",
+			mockError:      nil,
+			depositCalled:  true,
+		},
+		{
+			name:           "Idempotency key reused with a different request",
+			requestBody:    `{"amount": 100}`,
+			expectedCode:   http.StatusConflict,
+			idempotencyKey: "key-1",
+			mockError:      domain.ErrIdempotencyKeyReused,
+			depositCalled:  true,
+		},
+		{
+			name:          "Unknown currency",
+			requestBody:   `{"amount": 100, "currency": "XYZ"}`,
+			expectedCode:  http.StatusBadRequest,
+			mockError:     domain.ErrInvalidCurrency,
+			depositCalled: true,
+		},
+		{
+			name:          "Negative amount",
+			requestBody:   `{"amount": -500}`,
+			expectedCode:  http.StatusUnprocessableEntity,
+			mockError:     domain.ErrInvalidAmount,
+			depositCalled: true,
+		},
 		{
 			name:          "Internal server error",
 			requestBody:   `{"amount": 100}`,
@@ -56,21 +91,28 @@ func TestCreateDepositHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDepositUC := &mocks.DepositUseCaseMock{
-				DepositFunc: func(ctx context.Context, input account.DepositInput) error {
+				DepositFunc: func(ctx context.Context, input account.DepositInput) (int64, error) {
 					if tt.invalidInput {
-						return fmt.Errorf("invalid input")
+						return 0, fmt.Errorf("invalid input")
 					}
-					return tt.mockError
+					return 1, tt.mockError
 				},
 			}
 
 			req := NewTestHTTPRequest(http.MethodPost, "/deposit/example-account", strings.NewReader(tt.requestBody))
+			if tt.idempotencyKey != "" {
+				req.Header.Set(v1.IdempotencyKeyHeader, tt.idempotencyKey)
+			}
 			w := NewTestHTTPResponseWriter()
 			handler := v1.CreateDepositHandler(mockDepositUC)
 			handler(w, req)
 
 			assert.Equal(t, tt.expectedCode, w.(*httptest.ResponseRecorder).Code, "unexpected status code")
 
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.(*httptest.ResponseRecorder).Body.String()), "unexpected body")
+			}
+
 			if tt.depositCalled {
 				calls := mockDepositUC.DepositCalls()
 				assert.Len(t, calls, 1, "expected Deposit to be called once")
@@ -81,6 +123,13 @@ func TestCreateDepositHandler(t *testing.T) {
 					assert.NoError(t, err, "error decoding deposit input")
 
 					assert.Equal(t, depositInput.Amount, calls[0].Input.Amount, "unexpected Deposit call arguments")
+					assert.Equal(t, tt.idempotencyKey, calls[0].Input.IdempotencyKey, "unexpected Deposit call arguments")
+
+					wantCurrency := depositInput.Currency
+					if wantCurrency == "" {
+						wantCurrency = entities.DefaultCurrencyCode
+					}
+					assert.Equal(t, wantCurrency, calls[0].Input.Currency, "unexpected Deposit call arguments")
 				} else {
 					assert.Empty(t, calls[0].Input, "unexpected Deposit call arguments")
 				}
@@ -96,20 +145,51 @@ func TestCreateTransferHandler(t *testing.T) {
 		name           string
 		requestBody    string
 		expectedCode   int
+		idempotencyKey string
 		mockError      error
 		transferCalled bool
 		invalidInput   bool
 	}{
 		{
 			name:           "Valid request",
-			requestBody:    `{"to": "destination-account", "amount": 100}`,
+			requestBody:    `{"to": "destination-account", "amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusCreated,
+			mockError:      nil,
+			transferCalled: true,
+		},
+		{
+			name:           "With idempotency key",
+			requestBody:    `{"to": "destination-account", "amount": 100, "currency": "EUR"}`,
 			expectedCode:   http.StatusCreated,
+			idempotencyKey: "key-1",
 			mockError:      nil,
 			transferCalled: true,
 		},
+		{
+			name:           "Idempotency key reused with a different request",
+			requestBody:    `{"to": "destination-account", "amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusConflict,
+			idempotencyKey: "key-1",
+			mockError:      domain.ErrIdempotencyKeyReused,
+			transferCalled: true,
+		},
+		{
+			name:           "Self transfer",
+			requestBody:    `{"to": "source-account", "amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusUnprocessableEntity,
+			mockError:      domain.ErrSelfTransfer,
+			transferCalled: true,
+		},
+		{
+			name:           "Empty destination",
+			requestBody:    `{"to": "", "amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusUnprocessableEntity,
+			mockError:      domain.ErrEmptyAccount,
+			transferCalled: true,
+		},
 		{
 			name:           "Internal server error",
-			requestBody:    `{"to": "destination-account", "amount": 100}`,
+			requestBody:    `{"to": "destination-account", "amount": 100, "currency": "EUR"}`,
 			expectedCode:   http.StatusInternalServerError,
 			mockError:      assert.AnError,
 			transferCalled: true,
@@ -119,14 +199,17 @@ func TestCreateTransferHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockTransferUC := &mocks.TransferUseCaseMock{
-				TransferFunc: func(ctx context.Context, input account.TransferInput) error {
+				TransferFunc: func(ctx context.Context, input account.TransferInput) (int64, error) {
 					if tt.invalidInput {
-						return assert.AnError
+						return 0, assert.AnError
 					}
-					return tt.mockError
+					return 1, tt.mockError
 				},
 			}
 			req := NewTestHTTPRequest(http.MethodPost, "/transfer/source-account", strings.NewReader(tt.requestBody))
+			if tt.idempotencyKey != "" {
+				req.Header.Set(v1.IdempotencyKeyHeader, tt.idempotencyKey)
+			}
 			accountParam := chi.URLParam(req, "account")
 			req = req.WithContext(context.WithValue(req.Context(), "account", accountParam))
 
@@ -146,9 +229,11 @@ func TestCreateTransferHandler(t *testing.T) {
 					assert.NoError(t, err, "error decoding transfer request")
 
 					expectedInput := account.TransferInput{
-						FromAccount: accountParam,
-						ToAccount:   transferRequest.To,
-						Amount:      transferRequest.Amount,
+						FromAccount:    accountParam,
+						ToAccount:      transferRequest.To,
+						Amount:         transferRequest.Amount,
+						Currency:       "EUR",
+						IdempotencyKey: tt.idempotencyKey,
 					}
 
 					assert.Equal(t, expectedInput, calls[0].Input, "unexpected Transfer call arguments")
@@ -159,37 +244,223 @@ func TestCreateTransferHandler(t *testing.T) {
 	}
 }
 
+func TestCreateWithdrawalHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedCode   int
+		idempotencyKey string
+		mockError      error
+		withdrawCalled bool
+		wantCurrency   string
+	}{
+		{
+			name:           "Valid request",
+			requestBody:    `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusCreated,
+			withdrawCalled: true,
+			wantCurrency:   "EUR",
+		},
+		{
+			name:           "Default currency",
+			requestBody:    `{"amount": 100}`,
+			expectedCode:   http.StatusCreated,
+			withdrawCalled: true,
+			wantCurrency:   "USD",
+		},
+		{
+			name:           "With idempotency key",
+			requestBody:    `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusCreated,
+			idempotencyKey: "key-1",
+			withdrawCalled: true,
+			wantCurrency:   "EUR",
+		},
+		{
+			name:           "Insufficient funds",
+			requestBody:    `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusPaymentRequired,
+			mockError:      domain.ErrInsufficientFunds,
+			withdrawCalled: true,
+			wantCurrency:   "EUR",
+		},
+		{
+			name:           "Non-positive amount",
+			requestBody:    `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusUnprocessableEntity,
+			mockError:      domain.ErrInvalidAmount,
+			withdrawCalled: true,
+			wantCurrency:   "EUR",
+		},
+		{
+			name:           "Idempotency key reused with a different request",
+			requestBody:    `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusConflict,
+			idempotencyKey: "key-1",
+			mockError:      domain.ErrIdempotencyKeyReused,
+			withdrawCalled: true,
+			wantCurrency:   "EUR",
+		},
+		{
+			name:           "Internal server error",
+			requestBody:    `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:   http.StatusInternalServerError,
+			mockError:      assert.AnError,
+			withdrawCalled: true,
+			wantCurrency:   "EUR",
+		},
+		{
+			name:         "Invalid request payload",
+			requestBody:  `{"amount": "100"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWithdrawUC := &mocks.WithdrawUseCaseMock{
+				WithdrawFunc: func(ctx context.Context, input account.WithdrawInput) (int64, error) {
+					return 1, tt.mockError
+				},
+			}
+			req := NewTestHTTPRequest(http.MethodPost, "/withdrawals/source-account", strings.NewReader(tt.requestBody))
+			if tt.idempotencyKey != "" {
+				req.Header.Set(v1.IdempotencyKeyHeader, tt.idempotencyKey)
+			}
+			accountParam := chi.URLParam(req, "account")
+
+			w := NewTestHTTPResponseWriter()
+			handler := v1.CreateWithdrawalHandler(mockWithdrawUC)
+			handler(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.(*httptest.ResponseRecorder).Code, "unexpected status code")
+
+			if tt.withdrawCalled {
+				calls := mockWithdrawUC.WithdrawCalls()
+				assert.Len(t, calls, 1, "expected Withdraw to be called once")
+
+				expectedInput := account.WithdrawInput{
+					Account:        accountParam,
+					Amount:         100,
+					Currency:       tt.wantCurrency,
+					IdempotencyKey: tt.idempotencyKey,
+				}
+				assert.Equal(t, expectedInput, calls[0].Input, "unexpected Withdraw call arguments")
+			} else {
+				assert.Empty(t, mockWithdrawUC.WithdrawCalls(), "expected Withdraw not to be called")
+			}
+		})
+	}
+}
+
 func TestGetBalanceHandler(t *testing.T) {
+	asOf := time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC)
 	tests := []struct {
 		name          string
 		account       string
+		query         string
 		expectedCode  int
 		expectedBody  string
-		useCaseReturn int64
+		expectedAsOf  *time.Time
+		expectedRoll  bool
+		useCaseReturn []entities.Balance
 		useCaseError  error
+		balanceCalls  int
 	}{
 		{
-			name:          "Success",
-			account:       "123",
+			name:         "Success",
+			account:      "123",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balances":[{"currency":"EUR","exponent":2,"amount":50,"available":50},{"currency":"USD","exponent":2,"amount":100,"available":60}]}`,
+			useCaseReturn: []entities.Balance{
+				{Currency: entities.Currency{Code: "EUR", Exponent: 2}, Amount: 50, Available: 50},
+				{Currency: entities.Currency{Code: "USD", Exponent: 2}, Amount: 100, Available: 60},
+			},
+			useCaseError: nil,
+			balanceCalls: 1,
+		},
+		{
+			name:         "As of",
+			account:      "123",
+			query:        "?as_of=2026-09-30T23:59:59Z",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balances":[{"currency":"USD","exponent":2,"amount":70,"available":70}]}`,
+			expectedAsOf: &asOf,
+			useCaseReturn: []entities.Balance{
+				{Currency: entities.Currency{Code: "USD", Exponent: 2}, Amount: 70, Available: 70},
+			},
+			balanceCalls: 1,
+		},
+		{
+			name:         "Invalid as of",
+			account:      "123",
+			query:        "?as_of=yesterday",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid as_of: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\"","instance":"/balance/123","code":"invalid_request"}`,
+			balanceCalls: 0,
+		},
+		{
+			name:         "Include children",
+			account:      "assets",
+			query:        "?include_children=true",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balances":[{"currency":"USD","exponent":2,"amount":130,"available":130}]}`,
+			expectedRoll: true,
+			useCaseReturn: []entities.Balance{
+				{Currency: entities.Currency{Code: "USD", Exponent: 2}, Amount: 130, Available: 130},
+			},
+			balanceCalls: 1,
+		},
+		{
+			name:         "Invalid include children",
+			account:      "assets",
+			query:        "?include_children=maybe",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid include_children: strconv.ParseBool: parsing \"maybe\": invalid syntax","instance":"/balance/assets","code":"invalid_request"}`,
+			balanceCalls: 0,
+		},
+		{
+			name:          "No entries",
+			account:       "789",
 			expectedCode:  http.StatusOK,
-			expectedBody:  `{"balance":100}`,
-			useCaseReturn: 100,
+			expectedBody:  `{"balances":[]}`,
+			useCaseReturn: []entities.Balance{},
 			useCaseError:  nil,
+			balanceCalls:  1,
+		},
+		{
+			name:         "Account not found",
+			account:      "999",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"account not found","instance":"/balance/999","code":"account_not_found"}`,
+			useCaseError: domain.ErrAccountNotFound,
+			balanceCalls: 1,
+		},
+		{
+			name:         "Include children of an unknown account",
+			account:      "999",
+			query:        "?include_children=true",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"account not found","instance":"/balance/999","code":"account_not_found"}`,
+			expectedRoll: true,
+			useCaseError: domain.ErrAccountNotFound,
+			balanceCalls: 1,
 		},
 		{
 			name:          "Error from UseCase",
 			account:       "456",
 			expectedCode:  http.StatusInternalServerError,
-			expectedBody:  `mocked error`,
-			useCaseReturn: 0,
+			expectedBody:  `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/balance/456","code":"internal_error"}`,
+			useCaseReturn: nil,
 			useCaseError:  fmt.Errorf("mocked error"),
+			balanceCalls:  1,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := &mocks.BalanceUseCaseMock{
-				BalanceFunc: func(ctx context.Context, account string) (int64, error) {
+				BalanceFunc: func(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error) {
 					return tt.useCaseReturn, tt.useCaseError
 				},
 			}
@@ -198,7 +469,7 @@ func TestGetBalanceHandler(t *testing.T) {
 
 			rctx := chi.NewRouteContext()
 			rctx.URLParams.Add("account", tt.account)
-			req := httptest.NewRequest("GET", "/balance/"+tt.account, nil)
+			req := httptest.NewRequest("GET", "/balance/"+tt.account+tt.query, nil)
 			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
 
 			w := httptest.NewRecorder()
@@ -206,24 +477,29 @@ func TestGetBalanceHandler(t *testing.T) {
 
 			assert.Equal(t, tt.expectedCode, w.Code)
 
-			expectedBodyWithoutNewline := strings.ReplaceAll(tt.expectedBody, "\n", "")
+			responseBodyWithoutNewline := strings.ReplaceAll(strings.TrimSpace(w.Body.String()), "\n", "")
 
-			assert.Equal(t, tt.expectedBody, expectedBodyWithoutNewline)
+			assert.Equal(t, tt.expectedBody, responseBodyWithoutNewline)
 
 			calls := mockUseCase.BalanceCalls()
-			assert.Len(t, calls, 1)
-			assert.Equal(t, tt.account, calls[0].Account)
+			assert.Len(t, calls, tt.balanceCalls)
+			if tt.balanceCalls > 0 {
+				assert.Equal(t, account.BalanceInput{Account: tt.account, AsOf: tt.expectedAsOf, IncludeChildren: tt.expectedRoll}, calls[0].Input)
+			}
 		})
 	}
 }
 
 func TestGetTransfersHistoryHandler(t *testing.T) {
 	timeMock := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name           string
 		account        string
+		query          string
 		expectedCode   int
 		expectedBody   string
+		expectedInput  account.TransfersHistoryInput
 		useCaseReturn  *account.TransfersHistoryOutput
 		useCaseError   error
 		transfersCalls int
@@ -232,16 +508,63 @@ func TestGetTransfersHistoryHandler(t *testing.T) {
 			name:           "Success",
 			account:        "123",
 			expectedCode:   http.StatusOK,
-			expectedBody:   `{"entries":[{"ID":42,"Account":"jc","Direction":"debit","Amount":10000,"CreatedAt":"2023-12-05T01:56:57.324392Z"}]}`,
-			useCaseReturn:  &account.TransfersHistoryOutput{Entries: []entities.Entry{{ID: 42, Account: "jc", Direction: "debit", Amount: 10000, CreatedAt: &timeMock}}},
+			expectedBody:   `{"entries":[{"ID":42,"TransactionID":7,"Account":"jc","Direction":"debit","Amount":10000,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":"2023-12-05T01:56:57.324392Z"}]}`,
+			expectedInput:  account.TransfersHistoryInput{Account: "123"},
+			useCaseReturn:  &account.TransfersHistoryOutput{Entries: []entities.Entry{{ID: 42, TransactionID: 7, Account: "jc", Direction: "debit", Amount: 10000, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: entities.TransactionPosted, CreatedAt: &timeMock}}},
 			useCaseError:   nil,
 			transfersCalls: 1,
 		},
+		{
+			name:         "Filtered page",
+			account:      "123",
+			query:        "?limit=1&cursor=abc&from=2023-12-01T00:00:00Z&direction=debit&min_amount=100&max_amount=20000",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"entries":[{"ID":42,"TransactionID":7,"Account":"jc","Direction":"debit","Amount":10000,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":"2023-12-05T01:56:57.324392Z"}],"next_cursor":"def"}`,
+			expectedInput: account.TransfersHistoryInput{
+				Account:   "123",
+				From:      &from,
+				Direction: entities.Debit,
+				MinAmount: 100,
+				MaxAmount: 20000,
+				Cursor:    "abc",
+				Limit:     1,
+			},
+			useCaseReturn: &account.TransfersHistoryOutput{
+				Entries:    []entities.Entry{{ID: 42, TransactionID: 7, Account: "jc", Direction: "debit", Amount: 10000, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: entities.TransactionPosted, CreatedAt: &timeMock}},
+				NextCursor: "def",
+			},
+			transfersCalls: 1,
+		},
+		{
+			name:           "Invalid limit",
+			account:        "123",
+			query:          "?limit=ten",
+			expectedCode:   http.StatusBadRequest,
+			transfersCalls: 0,
+		},
+		{
+			name:           "Invalid date",
+			account:        "123",
+			query:          "?to=yesterday",
+			expectedCode:   http.StatusBadRequest,
+			transfersCalls: 0,
+		},
+		{
+			name:           "Invalid cursor",
+			account:        "123",
+			query:          "?cursor=abc",
+			expectedCode:   http.StatusBadRequest,
+			expectedBody:   `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid cursor","instance":"/transfers/123","code":"invalid_cursor"}`,
+			expectedInput:  account.TransfersHistoryInput{Account: "123", Cursor: "abc"},
+			useCaseError:   domain.ErrInvalidCursor,
+			transfersCalls: 1,
+		},
 		{
 			name:           "Error from UseCase",
 			account:        "456",
 			expectedCode:   http.StatusInternalServerError,
-			expectedBody:   `mocked error`,
+			expectedBody:   `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/transfers/456","code":"internal_error"}`,
+			expectedInput:  account.TransfersHistoryInput{Account: "456"},
 			useCaseReturn:  nil,
 			useCaseError:   fmt.Errorf("mocked error"),
 			transfersCalls: 1,
@@ -251,6 +574,7 @@ func TestGetTransfersHistoryHandler(t *testing.T) {
 			account:        "789",
 			expectedCode:   http.StatusOK,
 			expectedBody:   `{"entries":[]}`,
+			expectedInput:  account.TransfersHistoryInput{Account: "789"},
 			useCaseReturn:  &account.TransfersHistoryOutput{Entries: []entities.Entry{}},
 			useCaseError:   nil,
 			transfersCalls: 1,
@@ -260,7 +584,7 @@ func TestGetTransfersHistoryHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := &mocks.TransfersHistoryUseCaseMock{
-				TransfersHistoryFunc: func(ctx context.Context, account string) (*account.TransfersHistoryOutput, error) {
+				TransfersHistoryFunc: func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
 					return tt.useCaseReturn, tt.useCaseError
 				},
 			}
@@ -269,7 +593,7 @@ func TestGetTransfersHistoryHandler(t *testing.T) {
 
 			rctx := chi.NewRouteContext()
 			rctx.URLParams.Add("account", tt.account)
-			req := httptest.NewRequest("GET", "/transfers/"+tt.account, nil)
+			req := httptest.NewRequest("GET", "/transfers/"+tt.account+tt.query, nil)
 			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
 
 			w := httptest.NewRecorder()
@@ -277,16 +601,85 @@ func TestGetTransfersHistoryHandler(t *testing.T) {
 
 			assert.Equal(t, tt.expectedCode, w.Code)
 
-			// Remover '\n' da string expectedBody
-			expectedBodyWithoutNewline := strings.ReplaceAll(tt.expectedBody, "\n", "")
-
-			responseBodyWithoutNewline := strings.ReplaceAll(strings.TrimSpace(w.Body.String()), "\n", "")
-			assert.Equal(t, expectedBodyWithoutNewline, responseBodyWithoutNewline)
+			if tt.expectedBody != "" {
+				responseBodyWithoutNewline := strings.ReplaceAll(strings.TrimSpace(w.Body.String()), "\n", "")
+				assert.Equal(t, tt.expectedBody, responseBodyWithoutNewline)
+			}
 
 			calls := mockUseCase.TransfersHistoryCalls()
 			assert.Len(t, calls, tt.transfersCalls)
 			if tt.transfersCalls > 0 {
-				assert.Equal(t, tt.account, calls[0].AccountMoqParam)
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestCreatePendingTransferHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.TransferInput
+		mockError     error
+		pendingCalls  int
+	}{
+		{
+			name:          "Valid request",
+			requestBody:   `{"to": "merchant", "amount": 100}`,
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `{"transaction_id":1}`,
+			expectedInput: account.TransferInput{FromAccount: "jc", ToAccount: "merchant", Amount: 100, Currency: "USD", IdempotencyKey: "key"},
+			pendingCalls:  1,
+		},
+		{
+			name:         "Invalid payload",
+			requestBody:  `{"to": `,
+			expectedCode: http.StatusBadRequest,
+			pendingCalls: 0,
+		},
+		{
+			name:          "Insufficient funds",
+			requestBody:   `{"to": "merchant", "amount": 100, "currency": "EUR"}`,
+			expectedCode:  http.StatusPaymentRequired,
+			expectedBody:  `{"type":"about:blank","title":"Payment Required","status":402,"detail":"insufficient funds","instance":"/account/jc/pending-transfers","code":"insufficient_funds"}`,
+			expectedInput: account.TransferInput{FromAccount: "jc", ToAccount: "merchant", Amount: 100, Currency: "EUR", IdempotencyKey: "key"},
+			mockError:     domain.ErrInsufficientFunds,
+			pendingCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.PendingTransferUseCaseMock{
+				PendingTransferFunc: func(ctx context.Context, input account.TransferInput) (int64, error) {
+					if tt.mockError != nil {
+						return 0, tt.mockError
+					}
+					return 1, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", "jc")
+			req := httptest.NewRequest(http.MethodPost, "/account/jc/pending-transfers", strings.NewReader(tt.requestBody))
+			req.Header.Set(v1.IdempotencyKeyHeader, "key")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.CreatePendingTransferHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.PendingTransferCalls()
+			assert.Len(t, calls, tt.pendingCalls)
+			if tt.pendingCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
 			}
 		})
 	}
@@ -0,0 +1,384 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// AccountResponse is a registered ledger account.
+type AccountResponse struct {
+	ID string `json:"id"`
+	// Reference to the customer or system owning the account
+	Owner string `json:"owner"`
+	// Account this one rolls up into, omitted for root accounts
+	Parent string `json:"parent,omitempty"`
+	// Determines the normal balance side: debit for asset and expense
+	// accounts, credit for the others
+	Type   entities.AccountType   `json:"type"`
+	Status entities.AccountStatus `json:"status"`
+	// How far below zero postings may take the balance, in minor units;
+	// -1 means unlimited
+	OverdraftLimit int64      `json:"overdraft_limit"`
+	CreatedAt      *time.Time `json:"created_at"`
+}
+
+func newAccountResponse(a *entities.Account) AccountResponse {
+	return AccountResponse{
+		ID:             a.ID,
+		Owner:          a.Owner,
+		Parent:         a.Parent,
+		Type:           a.Type,
+		Status:         a.Status,
+		OverdraftLimit: a.OverdraftLimit,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+type CreateAccountRequest struct {
+	ID string `json:"id"`
+	// Reference to the customer or system owning the account
+	Owner string `json:"owner"`
+	// Account type, asset when omitted
+	Type string `json:"type" enums:"asset,liability,equity,revenue,expense"`
+	// Account this one rolls up into, which must have the same type
+	Parent string `json:"parent,omitempty"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/create_account_uc.go . CreateAccountUseCase
+type CreateAccountUseCase interface {
+	CreateAccount(ctx context.Context, input account.CreateAccountInput) (*entities.Account, error)
+}
+
+// CreateAccountHandler godoc
+// @Summary      Create an account
+// @Description  Register a new open account
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param request body CreateAccountRequest true "Account details"
+// @Success      201 {object} AccountResponse "Account created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      400 {object} Problem "Invalid account type"
+// @Failure      409 {object} Problem "Account already exists"
+// @Failure      422 {object} Problem "Invalid account ID or parent"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts [post]
+func CreateAccountHandler(uc CreateAccountUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateAccountRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.CreateAccountInput{
+			ID:     req.ID,
+			Owner:  req.Owner,
+			Type:   req.Type,
+			Parent: req.Parent,
+		}
+		a, err := uc.CreateAccount(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(newAccountResponse(a))
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/get_account_uc.go . GetAccountUseCase
+type GetAccountUseCase interface {
+	GetAccount(ctx context.Context, id string) (*entities.Account, error)
+}
+
+// GetAccountHandler godoc
+// @Summary      Get an account
+// @Description  Get a registered account with its status
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Success      200 {object} AccountResponse "Account retrieved successfully"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts/{account} [get]
+func GetAccountHandler(uc GetAccountUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a, err := uc.GetAccount(r.Context(), chi.URLParam(r, "account"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newAccountResponse(a))
+	}
+}
+
+type AccountsResponse struct {
+	Accounts []AccountResponse `json:"accounts"`
+	// Fetches the next page, empty on the last one
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/list_accounts_uc.go . ListAccountsUseCase
+type ListAccountsUseCase interface {
+	ListAccounts(ctx context.Context, input account.ListAccountsInput) (*account.ListAccountsOutput, error)
+}
+
+// ListAccountsHandler godoc
+// @Summary      List accounts
+// @Description  Get a page of the registered accounts, ordered by ID
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        owner query string false "Only accounts of this owner"
+// @Param        parent query string false "Only the children of this account"
+// @Param        type query string false "Only accounts of this type" Enums(asset, liability, equity, revenue, expense)
+// @Param        status query string false "Only accounts in this status" Enums(open, frozen, closed)
+// @Param        limit query int false "Page size, up to 500" default(50)
+// @Param        cursor query string false "next_cursor of the previous page"
+// @Success      200 {object} AccountsResponse "Accounts retrieved successfully"
+// @Failure      400 {object} Problem "Invalid query parameters"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts [get]
+func ListAccountsHandler(uc ListAccountsUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		input := account.ListAccountsInput{
+			Owner:  q.Get("owner"),
+			Parent: q.Get("parent"),
+			Type:   q.Get("type"),
+			Status: q.Get("status"),
+			Cursor: q.Get("cursor"),
+		}
+
+		if v := q.Get("limit"); v != "" {
+			limit, err := strconv.Atoi(v)
+			if err != nil {
+				writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid limit: "+err.Error())
+				return
+			}
+			input.Limit = limit
+		}
+
+		output, err := uc.ListAccounts(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := AccountsResponse{
+			Accounts:   make([]AccountResponse, 0, len(output.Accounts)),
+			NextCursor: output.NextCursor,
+		}
+		for i := range output.Accounts {
+			resp.Accounts = append(resp.Accounts, newAccountResponse(&output.Accounts[i]))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+type UpdateAccountRequest struct {
+	// New owner, unchanged when omitted
+	Owner *string `json:"owner"`
+	// New status, unchanged when omitted. Closed accounts cannot be reopened.
+	Status *string `json:"status" enums:"open,frozen,closed"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/update_account_uc.go . UpdateAccountUseCase
+type UpdateAccountUseCase interface {
+	UpdateAccount(ctx context.Context, input account.UpdateAccountInput) (*entities.Account, error)
+}
+
+// UpdateAccountHandler godoc
+// @Summary      Update an account
+// @Description  Change the owner or the status of an account, e.g. to freeze or unfreeze it
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Param request body UpdateAccountRequest true "Fields to change"
+// @Success      200 {object} AccountResponse "Account updated successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      409 {object} Problem "Status transition not allowed"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts/{account} [patch]
+func UpdateAccountHandler(uc UpdateAccountUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateAccountRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.UpdateAccountInput{
+			ID:     chi.URLParam(r, "account"),
+			Owner:  req.Owner,
+			Status: req.Status,
+		}
+		a, err := uc.UpdateAccount(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newAccountResponse(a))
+	}
+}
+
+// CloseAccountHandler godoc
+// @Summary      Close an account
+// @Description  Close an account for good. Accounts are never removed, so their history stays available.
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Success      200 {object} AccountResponse "Account closed successfully"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts/{account} [delete]
+func CloseAccountHandler(uc UpdateAccountUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		closed := string(entities.AccountClosed)
+		input := account.UpdateAccountInput{
+			ID:     chi.URLParam(r, "account"),
+			Status: &closed,
+		}
+		a, err := uc.UpdateAccount(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newAccountResponse(a))
+	}
+}
+
+type OverdraftLimitRequest struct {
+	// How far below zero postings may take the balance, in minor units;
+	// -1 means unlimited
+	Limit int64 `json:"limit"`
+	// Kept in the audit trail of the change
+	Reason string `json:"reason"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/set_overdraft_limit_uc.go . SetOverdraftLimitUseCase
+type SetOverdraftLimitUseCase interface {
+	SetOverdraftLimit(ctx context.Context, input account.SetOverdraftLimitInput) (*entities.Account, error)
+}
+
+// SetOverdraftLimitHandler godoc
+// @Summary      Set the overdraft limit of an account
+// @Description  Change how far below zero postings may take the balance of an account in each currency. The change is recorded in the account's audit trail.
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Param request body OverdraftLimitRequest true "New limit"
+// @Success      200 {object} AccountResponse "Overdraft limit changed successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      422 {object} Problem "Invalid overdraft limit"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts/{account}/overdraft-limit [put]
+func SetOverdraftLimitHandler(uc SetOverdraftLimitUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req OverdraftLimitRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.SetOverdraftLimitInput{
+			Account: chi.URLParam(r, "account"),
+			Limit:   req.Limit,
+			Reason:  req.Reason,
+		}
+		a, err := uc.SetOverdraftLimit(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newAccountResponse(a))
+	}
+}
+
+// OverdraftLimitChange is an entry of the audit trail of an account's
+// overdraft limit.
+type OverdraftLimitChange struct {
+	ID        int64      `json:"id"`
+	OldLimit  int64      `json:"old_limit"`
+	NewLimit  int64      `json:"new_limit"`
+	Reason    string     `json:"reason"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type OverdraftLimitChangesResponse struct {
+	Changes []OverdraftLimitChange `json:"changes"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/overdraft_limit_changes_uc.go . OverdraftLimitChangesUseCase
+type OverdraftLimitChangesUseCase interface {
+	OverdraftLimitChanges(ctx context.Context, account string) ([]entities.OverdraftLimitChange, error)
+}
+
+// GetOverdraftLimitChangesHandler godoc
+// @Summary      Get the overdraft limit audit trail of an account
+// @Description  Get every change of the overdraft limit of an account, newest first
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Success      200 {object} OverdraftLimitChangesResponse "Changes retrieved successfully"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/accounts/{account}/overdraft-limit/changes [get]
+func GetOverdraftLimitChangesHandler(uc OverdraftLimitChangesUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		changes, err := uc.OverdraftLimitChanges(r.Context(), chi.URLParam(r, "account"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := OverdraftLimitChangesResponse{
+			Changes: make([]OverdraftLimitChange, 0, len(changes)),
+		}
+		for _, c := range changes {
+			resp.Changes = append(resp.Changes, OverdraftLimitChange{
+				ID:        c.ID,
+				OldLimit:  c.OldLimit,
+				NewLimit:  c.NewLimit,
+				Reason:    c.Reason,
+				CreatedAt: c.CreatedAt,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
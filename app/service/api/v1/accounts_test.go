@@ -0,0 +1,431 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAccountHandler(t *testing.T) {
+	timeMock := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	tests := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+		mockError    error
+		createCalls  int
+	}{
+		{
+			name:         "Valid request",
+			requestBody:  `{"id": "customers:jc", "owner": "customer-1", "type": "liability", "parent": "customers"}`,
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"id":"customers:jc","owner":"customer-1","parent":"customers","type":"liability","status":"open","overdraft_limit":0,"created_at":"2023-12-05T01:56:57.324392Z"}`,
+			createCalls:  1,
+		},
+		{
+			name:         "Invalid payload",
+			requestBody:  `{"id": `,
+			expectedCode: http.StatusBadRequest,
+			createCalls:  0,
+		},
+		{
+			name:         "Already exists",
+			requestBody:  `{"id": "jc"}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"type":"about:blank","title":"Conflict","status":409,"detail":"account already exists","instance":"/accounts","code":"account_already_exists"}`,
+			mockError:    domain.ErrAccountAlreadyExists,
+			createCalls:  1,
+		},
+		{
+			name:         "Unknown parent",
+			requestBody:  `{"id": "customers:jc", "parent": "customers"}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"parent account not found","instance":"/accounts","code":"parent_not_found"}`,
+			mockError:    domain.ErrParentNotFound,
+			createCalls:  1,
+		},
+		{
+			name:         "Invalid type",
+			requestBody:  `{"id": "jc", "type": "income"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid account type","instance":"/accounts","code":"invalid_account_type"}`,
+			mockError:    domain.ErrInvalidAccountType,
+			createCalls:  1,
+		},
+		{
+			name:         "Invalid account",
+			requestBody:  `{"id": "j c"}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			mockError:    domain.ErrInvalidAccount,
+			createCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.CreateAccountUseCaseMock{
+				CreateAccountFunc: func(ctx context.Context, input account.CreateAccountInput) (*entities.Account, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Account{ID: input.ID, Owner: input.Owner, Parent: input.Parent, Type: entities.AccountType(input.Type), Status: entities.AccountOpen, CreatedAt: &timeMock}, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/accounts", strings.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+			v1.CreateAccountHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			assert.Len(t, mockUseCase.CreateAccountCalls(), tt.createCalls)
+		})
+	}
+}
+
+func TestGetAccountHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		account      string
+		expectedCode int
+		expectedBody string
+		mockError    error
+	}{
+		{
+			name:         "Success",
+			account:      "jc",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"id":"jc","owner":"customer-1","type":"asset","status":"frozen","overdraft_limit":0,"created_at":null}`,
+		},
+		{
+			name:         "Not found",
+			account:      "unknown",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"account not found","instance":"/accounts/unknown","code":"account_not_found"}`,
+			mockError:    domain.ErrAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.GetAccountUseCaseMock{
+				GetAccountFunc: func(ctx context.Context, id string) (*entities.Account, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Account{ID: id, Owner: "customer-1", Type: entities.Asset, Status: entities.AccountFrozen}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", tt.account)
+			req := httptest.NewRequest(http.MethodGet, "/accounts/"+tt.account, nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.GetAccountHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+		})
+	}
+}
+
+func TestListAccountsHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.ListAccountsInput
+		mockError     error
+		listCalls     int
+	}{
+		{
+			name:          "Success",
+			query:         "?owner=customer-1&parent=customers&type=asset&status=open&cursor=a&limit=2",
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"accounts":[{"id":"b","owner":"customer-1","type":"asset","status":"open","overdraft_limit":0,"created_at":null},{"id":"c","owner":"customer-1","type":"asset","status":"open","overdraft_limit":0,"created_at":null}],"next_cursor":"c"}`,
+			expectedInput: account.ListAccountsInput{Owner: "customer-1", Parent: "customers", Type: "asset", Status: "open", Cursor: "a", Limit: 2},
+			listCalls:     1,
+		},
+		{
+			name:         "Invalid limit",
+			query:        "?limit=abc",
+			expectedCode: http.StatusBadRequest,
+			listCalls:    0,
+		},
+		{
+			name:          "Invalid status",
+			query:         "?status=deleted",
+			expectedCode:  http.StatusBadRequest,
+			expectedBody:  `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid account status","instance":"/accounts","code":"invalid_account_status"}`,
+			expectedInput: account.ListAccountsInput{Status: "deleted"},
+			mockError:     domain.ErrInvalidAccountStatus,
+			listCalls:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.ListAccountsUseCaseMock{
+				ListAccountsFunc: func(ctx context.Context, input account.ListAccountsInput) (*account.ListAccountsOutput, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &account.ListAccountsOutput{
+						Accounts: []entities.Account{
+							{ID: "b", Owner: "customer-1", Type: entities.Asset, Status: entities.AccountOpen},
+							{ID: "c", Owner: "customer-1", Type: entities.Asset, Status: entities.AccountOpen},
+						},
+						NextCursor: "c",
+					}, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/accounts"+tt.query, nil)
+			w := httptest.NewRecorder()
+			v1.ListAccountsHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.ListAccountsCalls()
+			assert.Len(t, calls, tt.listCalls)
+			if tt.listCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestUpdateAccountHandler(t *testing.T) {
+	frozen := "frozen"
+	closed := "closed"
+	tests := []struct {
+		name          string
+		method        string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.UpdateAccountInput
+		mockError     error
+		updateCalls   int
+	}{
+		{
+			name:          "Freeze",
+			method:        http.MethodPatch,
+			requestBody:   `{"status": "frozen"}`,
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"id":"jc","owner":"","type":"asset","status":"frozen","overdraft_limit":0,"created_at":null}`,
+			expectedInput: account.UpdateAccountInput{ID: "jc", Status: &frozen},
+			updateCalls:   1,
+		},
+		{
+			name:         "Invalid payload",
+			method:       http.MethodPatch,
+			requestBody:  `{"status": `,
+			expectedCode: http.StatusBadRequest,
+			updateCalls:  0,
+		},
+		{
+			name:          "Reopen closed account",
+			method:        http.MethodPatch,
+			requestBody:   `{"status": "open"}`,
+			expectedCode:  http.StatusConflict,
+			expectedBody:  `{"type":"about:blank","title":"Conflict","status":409,"detail":"invalid account status transition","instance":"/accounts/jc","code":"invalid_status_transition"}`,
+			expectedInput: account.UpdateAccountInput{ID: "jc", Status: func() *string { s := "open"; return &s }()},
+			mockError:     domain.ErrInvalidStatusTransition,
+			updateCalls:   1,
+		},
+		{
+			name:          "Close",
+			method:        http.MethodDelete,
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"id":"jc","owner":"","type":"asset","status":"closed","overdraft_limit":0,"created_at":null}`,
+			expectedInput: account.UpdateAccountInput{ID: "jc", Status: &closed},
+			updateCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.UpdateAccountUseCaseMock{
+				UpdateAccountFunc: func(ctx context.Context, input account.UpdateAccountInput) (*entities.Account, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Account{ID: input.ID, Type: entities.Asset, Status: entities.AccountStatus(*input.Status)}, nil
+				},
+			}
+
+			handler := v1.UpdateAccountHandler(mockUseCase)
+			if tt.method == http.MethodDelete {
+				handler = v1.CloseAccountHandler(mockUseCase)
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", "jc")
+			req := httptest.NewRequest(tt.method, "/accounts/jc", strings.NewReader(tt.requestBody))
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.UpdateAccountCalls()
+			assert.Len(t, calls, tt.updateCalls)
+			if tt.updateCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestSetOverdraftLimitHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.SetOverdraftLimitInput
+		mockError     error
+		setCalls      int
+	}{
+		{
+			name:          "Credit line",
+			requestBody:   `{"limit": 50000, "reason": "credit line approved"}`,
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"id":"jc","owner":"","type":"asset","status":"open","overdraft_limit":50000,"created_at":null}`,
+			expectedInput: account.SetOverdraftLimitInput{Account: "jc", Limit: 50000, Reason: "credit line approved"},
+			setCalls:      1,
+		},
+		{
+			name:         "Invalid payload",
+			requestBody:  `{"limit": "lots"}`,
+			expectedCode: http.StatusBadRequest,
+			setCalls:     0,
+		},
+		{
+			name:          "Invalid limit",
+			requestBody:   `{"limit": -5}`,
+			expectedCode:  http.StatusUnprocessableEntity,
+			expectedBody:  `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"invalid overdraft limit","instance":"/accounts/jc/overdraft-limit","code":"invalid_overdraft_limit"}`,
+			expectedInput: account.SetOverdraftLimitInput{Account: "jc", Limit: -5},
+			mockError:     domain.ErrInvalidOverdraftLimit,
+			setCalls:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.SetOverdraftLimitUseCaseMock{
+				SetOverdraftLimitFunc: func(ctx context.Context, input account.SetOverdraftLimitInput) (*entities.Account, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Account{ID: input.Account, Type: entities.Asset, Status: entities.AccountOpen, OverdraftLimit: input.Limit}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", "jc")
+			req := httptest.NewRequest(http.MethodPut, "/accounts/jc/overdraft-limit", strings.NewReader(tt.requestBody))
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.SetOverdraftLimitHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.SetOverdraftLimitCalls()
+			assert.Len(t, calls, tt.setCalls)
+			if tt.setCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestGetOverdraftLimitChangesHandler(t *testing.T) {
+	timeMock := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	tests := []struct {
+		name         string
+		account      string
+		expectedCode int
+		expectedBody string
+		mockError    error
+	}{
+		{
+			name:         "Success",
+			account:      "jc",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"changes":[` +
+				`{"id":2,"old_limit":500,"new_limit":-1,"reason":"","created_at":"2023-12-05T01:56:57.324392Z"},` +
+				`{"id":1,"old_limit":0,"new_limit":500,"reason":"credit line approved","created_at":"2023-12-05T01:56:57.324392Z"}` +
+				`]}`,
+		},
+		{
+			name:         "Not found",
+			account:      "unknown",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"account not found","instance":"/accounts/unknown/overdraft-limit/changes","code":"account_not_found"}`,
+			mockError:    domain.ErrAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.OverdraftLimitChangesUseCaseMock{
+				OverdraftLimitChangesFunc: func(ctx context.Context, account string) ([]entities.OverdraftLimitChange, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return []entities.OverdraftLimitChange{
+						{ID: 2, Account: account, OldLimit: 500, NewLimit: entities.UnlimitedOverdraft, CreatedAt: &timeMock},
+						{ID: 1, Account: account, OldLimit: 0, NewLimit: 500, Reason: "credit line approved", CreatedAt: &timeMock},
+					}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", tt.account)
+			req := httptest.NewRequest(http.MethodGet, "/accounts/"+tt.account+"/overdraft-limit/changes", nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.GetOverdraftLimitChangesHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+		})
+	}
+}
@@ -7,15 +7,66 @@ import (
 )
 
 type API struct {
-	CreateDepositHandler       http.HandlerFunc
-	CreateTransferHandler      http.HandlerFunc
-	GetBalanceHandler          http.HandlerFunc
-	GetTransfersHistoryHandler http.HandlerFunc
+	CreateDepositHandler             http.HandlerFunc
+	CreateTransferHandler            http.HandlerFunc
+	CreateWithdrawalHandler          http.HandlerFunc
+	GetBalanceHandler                http.HandlerFunc
+	GetTransfersHistoryHandler       http.HandlerFunc
+	CreateTransactionHandler         http.HandlerFunc
+	GetTransactionHandler            http.HandlerFunc
+	CreateAccountHandler             http.HandlerFunc
+	ListAccountsHandler              http.HandlerFunc
+	GetAccountHandler                http.HandlerFunc
+	UpdateAccountHandler             http.HandlerFunc
+	CloseAccountHandler              http.HandlerFunc
+	SetOverdraftLimitHandler         http.HandlerFunc
+	GetOverdraftLimitChangesHandler  http.HandlerFunc
+	CreateHoldHandler                http.HandlerFunc
+	CaptureHoldHandler               http.HandlerFunc
+	ReleaseHoldHandler               http.HandlerFunc
+	CreatePendingTransferHandler     http.HandlerFunc
+	PostTransactionHandler           http.HandlerFunc
+	VoidTransactionHandler           http.HandlerFunc
+	ReverseTransactionHandler        http.HandlerFunc
+	VerifyHashChainHandler           http.HandlerFunc
+	GetTrialBalanceHandler           http.HandlerFunc
+	GetUnbalancedTransactionsHandler http.HandlerFunc
+	CreateWebhookHandler             http.HandlerFunc
+	ListWebhooksHandler              http.HandlerFunc
+	DeleteWebhookHandler             http.HandlerFunc
+	GetWebhookDeliveriesHandler      http.HandlerFunc
+	StreamAccountEventsHandler       http.HandlerFunc
 }
 
 func (a *API) Routes(router *chi.Mux) {
 	router.Post("/api/v1/account/{account}/deposit", a.CreateDepositHandler)
 	router.Post("/api/v1/account/{account}/transfers", a.CreateTransferHandler)
+	router.Post("/api/v1/account/{account}/withdrawals", a.CreateWithdrawalHandler)
 	router.Get("/api/v1/account/{account}/balance", a.GetBalanceHandler)
 	router.Get("/api/v1/account/{account}/transfers", a.GetTransfersHistoryHandler)
+	router.Get("/api/v1/account/{account}/events", a.StreamAccountEventsHandler)
+	router.Post("/api/v1/transactions", a.CreateTransactionHandler)
+	router.Get("/api/v1/transactions/{id}", a.GetTransactionHandler)
+	router.Post("/api/v1/accounts", a.CreateAccountHandler)
+	router.Get("/api/v1/accounts", a.ListAccountsHandler)
+	router.Get("/api/v1/accounts/{account}", a.GetAccountHandler)
+	router.Get("/api/v1/accounts/{account}/balance", a.GetBalanceHandler)
+	router.Patch("/api/v1/accounts/{account}", a.UpdateAccountHandler)
+	router.Delete("/api/v1/accounts/{account}", a.CloseAccountHandler)
+	router.Put("/api/v1/accounts/{account}/overdraft-limit", a.SetOverdraftLimitHandler)
+	router.Get("/api/v1/accounts/{account}/overdraft-limit/changes", a.GetOverdraftLimitChangesHandler)
+	router.Post("/api/v1/account/{account}/holds", a.CreateHoldHandler)
+	router.Post("/api/v1/holds/{id}/capture", a.CaptureHoldHandler)
+	router.Post("/api/v1/holds/{id}/release", a.ReleaseHoldHandler)
+	router.Post("/api/v1/account/{account}/pending-transfers", a.CreatePendingTransferHandler)
+	router.Post("/api/v1/transactions/{id}/post", a.PostTransactionHandler)
+	router.Post("/api/v1/transactions/{id}/void", a.VoidTransactionHandler)
+	router.Post("/api/v1/transactions/{id}/reverse", a.ReverseTransactionHandler)
+	router.Get("/api/v1/audit/hash-chain", a.VerifyHashChainHandler)
+	router.Get("/api/v1/reports/trial-balance", a.GetTrialBalanceHandler)
+	router.Get("/api/v1/reports/unbalanced-transactions", a.GetUnbalancedTransactionsHandler)
+	router.Post("/api/v1/webhooks", a.CreateWebhookHandler)
+	router.Get("/api/v1/webhooks", a.ListWebhooksHandler)
+	router.Delete("/api/v1/webhooks/{id}", a.DeleteWebhookHandler)
+	router.Get("/api/v1/webhooks/{id}/deliveries", a.GetWebhookDeliveriesHandler)
 }
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// HashChainResponse is the outcome of verifying the entries' hash chain.
+type HashChainResponse struct {
+	// Whether every entry's hash matched
+	Valid bool `json:"valid"`
+	// Number of entries verified before the first broken link
+	Verified int64 `json:"verified"`
+	// First entry whose hash does not match, omitted when valid
+	BrokenEntryID int64 `json:"broken_entry_id,omitempty"`
+	// Hash computed for the broken entry
+	ExpectedHash string `json:"expected_hash,omitempty"`
+	// Hash stored for the broken entry, empty if it has none
+	StoredHash string `json:"stored_hash,omitempty"`
+}
+
+func newHashChainResponse(v *entities.ChainVerification) HashChainResponse {
+	resp := HashChainResponse{Valid: v.Valid(), Verified: v.Verified}
+	if v.Break != nil {
+		resp.BrokenEntryID = v.Break.EntryID
+		resp.ExpectedHash = v.Break.Expected
+		resp.StoredHash = v.Break.Stored
+	}
+
+	return resp
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/verify_chain_uc.go . VerifyChainUseCase
+type VerifyChainUseCase interface {
+	VerifyChain(ctx context.Context) (*entities.ChainVerification, error)
+}
+
+// VerifyHashChainHandler godoc
+// @Summary      Verify the hash chain
+// @Description  Walk the entries' hash chain, recomputing every entry's hash from its content and the previous entry's hash, and report the first broken link
+// @Tags         audit
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} HashChainResponse "Hash chain verified"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/audit/hash-chain [get]
+func VerifyHashChainHandler(uc VerifyChainUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v, err := uc.VerifyChain(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newHashChainResponse(v))
+	}
+}
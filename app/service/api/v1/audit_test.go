@@ -0,0 +1,65 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyHashChainHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		verification *entities.ChainVerification
+		mockError    error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Intact chain",
+			verification: &entities.ChainVerification{Verified: 8},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"valid":true,"verified":8}`,
+		},
+		{
+			name: "Broken chain",
+			verification: &entities.ChainVerification{Verified: 3, Break: &entities.ChainBreak{
+				EntryID:  4,
+				Expected: "5c1b",
+				Stored:   "a7e0",
+			}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"valid":false,"verified":3,"broken_entry_id":4,"expected_hash":"5c1b","stored_hash":"a7e0"}`,
+		},
+		{
+			name:         "Internal error",
+			mockError:    assert.AnError,
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/audit/hash-chain","code":"internal_error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.VerifyChainUseCaseMock{
+				VerifyChainFunc: func(ctx context.Context) (*entities.ChainVerification, error) {
+					return tt.verification, tt.mockError
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/audit/hash-chain", nil)
+			w := httptest.NewRecorder()
+			v1.VerifyHashChainHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			assert.Len(t, mockUseCase.VerifyChainCalls(), 1)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/julioc98/ledger/domain/account"
+)
+
+// keepAliveInterval is how long a stream may stay idle before a comment is
+// sent on it, so proxies do not drop the connection.
+const keepAliveInterval = 15 * time.Second
+
+//go:generate moq -stub -pkg mocks -out mocks/stream_entries_uc.go . StreamEntriesUseCase
+type StreamEntriesUseCase interface {
+	StreamEntries(ctx context.Context, input account.StreamEntriesInput) (*account.EntryStream, error)
+}
+
+// StreamAccountEventsHandler godoc
+// @Summary      Stream account activity
+// @Description  Server-Sent Events stream of the entries of an account, sent as they are committed. Each entry is an "entry" event whose id is the entry ID and whose data is the entry as in the transfer history. Reconnecting with the Last-Event-ID header resumes the stream after that entry; without it, the stream starts with the entries committed from then on.
+// @Tags         accounts
+// @Produce      text/event-stream
+// @Param        account path string true "Account ID"
+// @Param        Last-Event-ID header int false "ID of the last entry received"
+// @Success      200 {object} entities.Entry "Stream of entry events"
+// @Failure      400 {object} Problem "Invalid Last-Event-ID"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/account/{account}/events [get]
+func StreamAccountEventsHandler(uc StreamEntriesUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		input := account.StreamEntriesInput{Account: chi.URLParam(r, "account")}
+		if v := r.Header.Get("Last-Event-ID"); v != "" {
+			after, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid Last-Event-ID: "+err.Error())
+				return
+			}
+			input.After = after
+		}
+
+		stream, err := uc.StreamEntries(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		defer stream.Close()
+
+		// The stream outlives the server's write timeout.
+		rc := http.NewResponseController(w)
+		_ = rc.SetWriteDeadline(time.Time{})
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		for {
+			ctx, cancel := context.WithTimeout(r.Context(), keepAliveInterval)
+			entries, err := stream.Next(ctx)
+			cancel()
+
+			switch {
+			case r.Context().Err() != nil:
+				return
+			case errors.Is(err, context.DeadlineExceeded):
+				fmt.Fprint(w, ": keep-alive\n\n")
+			case err != nil:
+				// The client reconnects and resumes where it stopped.
+				return
+			}
+
+			for _, e := range entries {
+				fmt.Fprintf(w, "id: %d\nevent: entry\ndata: ", e.ID)
+				json.NewEncoder(w).Encode(e)
+				fmt.Fprint(w, "\n")
+			}
+
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
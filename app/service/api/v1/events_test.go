@@ -0,0 +1,128 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type streamRepository struct {
+	entries []entities.Entry
+}
+
+func (s streamRepository) Account(ctx context.Context, id string) (*entities.Account, error) {
+	return &entities.Account{ID: id}, nil
+}
+
+func (s streamRepository) LastEntryID(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (s streamRepository) EntriesAfter(ctx context.Context, account string, after int64, limit int) ([]entities.Entry, error) {
+	var entries []entities.Entry
+	for _, e := range s.entries {
+		if e.ID > after {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+type streamNotifier struct{}
+
+func (streamNotifier) Subscribe(account string) (<-chan struct{}, func()) {
+	return nil, func() {}
+}
+
+func TestStreamAccountEventsHandler(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	repo := streamRepository{entries: []entities.Entry{
+		{ID: 3, TransactionID: 2, Account: "jc", Direction: entities.Debit, Amount: 100, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: entities.TransactionPosted, CreatedAt: &createdAt},
+		{ID: 5, TransactionID: 3, Account: "jc", Direction: entities.Credit, Amount: 40, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: entities.TransactionPending, CreatedAt: &createdAt},
+	}}
+
+	tests := []struct {
+		name          string
+		lastEventID   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.StreamEntriesInput
+		mockError     error
+		streamCalls   int
+	}{
+		{
+			name:         "Resumed stream",
+			lastEventID:  "3",
+			expectedCode: http.StatusOK,
+			expectedBody: "id: 5\nevent: entry\ndata: " +
+				`{"ID":5,"TransactionID":3,"Account":"jc","Direction":"credit","Amount":40,"Currency":{"Code":"USD","Exponent":2},"Status":"pending","ReversalOf":0,"CreatedAt":"2026-10-18T12:00:00Z"}` + "\n\n",
+			expectedInput: account.StreamEntriesInput{Account: "jc", After: 3},
+			streamCalls:   1,
+		},
+		{
+			name:         "Invalid Last-Event-ID",
+			lastEventID:  "last",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid Last-Event-ID: strconv.ParseInt: parsing \"last\": invalid syntax","instance":"/account/jc/events","code":"invalid_request"}` + "\n",
+			streamCalls:  0,
+		},
+		{
+			name:          "Account not found",
+			expectedCode:  http.StatusNotFound,
+			expectedBody:  `{"type":"about:blank","title":"Not Found","status":404,"detail":"account not found","instance":"/account/jc/events","code":"account_not_found"}` + "\n",
+			expectedInput: account.StreamEntriesInput{Account: "jc"},
+			mockError:     domain.ErrAccountNotFound,
+			streamCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.StreamEntriesUseCaseMock{
+				StreamEntriesFunc: func(ctx context.Context, input account.StreamEntriesInput) (*account.EntryStream, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return account.NewStreamEntriesUseCase(repo, streamNotifier{}).StreamEntries(ctx, input)
+				},
+			}
+
+			// The stream ends when the client goes away
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", "jc")
+			req := httptest.NewRequest(http.MethodGet, "/account/jc/events", nil)
+			req = req.WithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx))
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+
+			w := httptest.NewRecorder()
+			v1.StreamAccountEventsHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			if tt.expectedCode == http.StatusOK {
+				assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			}
+
+			calls := mockUseCase.StreamEntriesCalls()
+			assert.Len(t, calls, tt.streamCalls)
+			if tt.streamCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// HoldResponse is a reservation of an account's funds.
+type HoldResponse struct {
+	ID      int64  `json:"id"`
+	Account string `json:"account"`
+	// Held amount in minor units
+	Amount int64 `json:"amount"`
+	// ISO 4217 currency code
+	Currency string              `json:"currency"`
+	Status   entities.HoldStatus `json:"status" enums:"active,captured,released,expired"`
+	// Amount transferred by the capture, omitted unless captured
+	Captured int64 `json:"captured,omitempty"`
+	// Transaction booking the capture, omitted unless captured
+	TransactionID int64      `json:"transaction_id,omitempty"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	CreatedAt     *time.Time `json:"created_at"`
+}
+
+func newHoldResponse(h *entities.Hold) HoldResponse {
+	return HoldResponse{
+		ID:            h.ID,
+		Account:       h.Account,
+		Amount:        h.Amount,
+		Currency:      h.Currency.Code,
+		Status:        h.Status,
+		Captured:      h.Captured,
+		TransactionID: h.TransactionID,
+		ExpiresAt:     h.ExpiresAt,
+		CreatedAt:     h.CreatedAt,
+	}
+}
+
+type CreateHoldRequest struct {
+	Amount int64 `json:"amount"`
+	// ISO 4217 currency code, defaults to USD
+	Currency string `json:"currency"`
+	// When the hold lapses, in a week when omitted
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/create_hold_uc.go . CreateHoldUseCase
+type CreateHoldUseCase interface {
+	CreateHold(ctx context.Context, input account.CreateHoldInput) (*entities.Hold, error)
+}
+
+// CreateHoldHandler godoc
+// @Summary      Create a hold
+// @Description  Reserve funds of an account until the hold is captured, released or expires. Held funds are not available to transfers, withdrawals or other holds.
+// @Tags         holds
+// @Accept       json
+// @Produce      json
+// @Param        account path string true "Account ID"
+// @Param request body CreateHoldRequest true "Hold details"
+// @Success      201 {object} HoldResponse "Hold created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      402 {object} Problem "Insufficient funds"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      409 {object} Problem "Account frozen or closed"
+// @Failure      422 {object} Problem "Invalid amount or expiry"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/account/{account}/holds [post]
+func CreateHoldHandler(uc CreateHoldUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateHoldRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.CreateHoldInput{
+			Account:   chi.URLParam(r, "account"),
+			Amount:    req.Amount,
+			Currency:  currencyOrDefault(req.Currency),
+			ExpiresAt: req.ExpiresAt,
+		}
+		h, err := uc.CreateHold(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(newHoldResponse(h))
+	}
+}
+
+type CaptureHoldRequest struct {
+	// Account receiving the funds
+	To string `json:"to"`
+	// Amount to transfer, up to the held amount; the whole hold when omitted
+	Amount int64 `json:"amount,omitempty"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/capture_hold_uc.go . CaptureHoldUseCase
+type CaptureHoldUseCase interface {
+	CaptureHold(ctx context.Context, input account.CaptureHoldInput) (*entities.Hold, error)
+}
+
+// CaptureHoldHandler godoc
+// @Summary      Capture a hold
+// @Description  Settle an active hold by transferring up to the held amount to another account. The rest of the hold is released.
+// @Tags         holds
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Hold ID"
+// @Param request body CaptureHoldRequest true "Capture details"
+// @Success      200 {object} HoldResponse "Hold captured successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      404 {object} Problem "Hold or account not found"
+// @Failure      409 {object} Problem "Hold not active or expired"
+// @Failure      422 {object} Problem "Amount exceeds the hold"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/holds/{id}/capture [post]
+func CaptureHoldHandler(uc CaptureHoldUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid hold ID")
+			return
+		}
+
+		var req CaptureHoldRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.CaptureHoldInput{
+			HoldID:    id,
+			ToAccount: req.To,
+			Amount:    req.Amount,
+		}
+		h, err := uc.CaptureHold(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newHoldResponse(h))
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/release_hold_uc.go . ReleaseHoldUseCase
+type ReleaseHoldUseCase interface {
+	ReleaseHold(ctx context.Context, id int64) (*entities.Hold, error)
+}
+
+// ReleaseHoldHandler godoc
+// @Summary      Release a hold
+// @Description  Give up an active hold, making its funds available again
+// @Tags         holds
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Hold ID"
+// @Success      200 {object} HoldResponse "Hold released successfully"
+// @Failure      400 {object} Problem "Invalid hold ID"
+// @Failure      404 {object} Problem "Hold not found"
+// @Failure      409 {object} Problem "Hold not active or expired"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/holds/{id}/release [post]
+func ReleaseHoldHandler(uc ReleaseHoldUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid hold ID")
+			return
+		}
+
+		h, err := uc.ReleaseHold(r.Context(), id)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newHoldResponse(h))
+	}
+}
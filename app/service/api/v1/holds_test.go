@@ -0,0 +1,257 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateHoldHandler(t *testing.T) {
+	expiresAt := time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.CreateHoldInput
+		mockError     error
+		createCalls   int
+	}{
+		{
+			name:          "Valid request",
+			requestBody:   `{"amount": 100, "expires_at": "2026-10-25T12:00:00Z"}`,
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `{"id":1,"account":"jc","amount":100,"currency":"USD","status":"active","expires_at":"2026-10-25T12:00:00Z","created_at":null}`,
+			expectedInput: account.CreateHoldInput{Account: "jc", Amount: 100, Currency: "USD", ExpiresAt: &expiresAt},
+			createCalls:   1,
+		},
+		{
+			name:         "Invalid payload",
+			requestBody:  `{"amount": "100"}`,
+			expectedCode: http.StatusBadRequest,
+			createCalls:  0,
+		},
+		{
+			name:          "Insufficient funds",
+			requestBody:   `{"amount": 100, "currency": "EUR"}`,
+			expectedCode:  http.StatusPaymentRequired,
+			expectedBody:  `{"type":"about:blank","title":"Payment Required","status":402,"detail":"insufficient funds","instance":"/account/jc/holds","code":"insufficient_funds"}`,
+			expectedInput: account.CreateHoldInput{Account: "jc", Amount: 100, Currency: "EUR"},
+			mockError:     domain.ErrInsufficientFunds,
+			createCalls:   1,
+		},
+		{
+			name:          "Expiry in the past",
+			requestBody:   `{"amount": 100, "expires_at": "2026-10-25T12:00:00Z"}`,
+			expectedCode:  http.StatusUnprocessableEntity,
+			expectedBody:  `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"hold expiry must be in the future","instance":"/account/jc/holds","code":"invalid_hold_expiry"}`,
+			expectedInput: account.CreateHoldInput{Account: "jc", Amount: 100, Currency: "USD", ExpiresAt: &expiresAt},
+			mockError:     domain.ErrInvalidHoldExpiry,
+			createCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.CreateHoldUseCaseMock{
+				CreateHoldFunc: func(ctx context.Context, input account.CreateHoldInput) (*entities.Hold, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					currency, _ := entities.NewCurrency(input.Currency)
+					return &entities.Hold{ID: 1, Account: input.Account, Amount: input.Amount, Currency: currency, Status: entities.HoldActive, ExpiresAt: *input.ExpiresAt}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("account", "jc")
+			req := httptest.NewRequest(http.MethodPost, "/account/jc/holds", strings.NewReader(tt.requestBody))
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.CreateHoldHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.CreateHoldCalls()
+			assert.Len(t, calls, tt.createCalls)
+			if tt.createCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestCaptureHoldHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.CaptureHoldInput
+		mockError     error
+		captureCalls  int
+	}{
+		{
+			name:          "Partial capture",
+			id:            "7",
+			requestBody:   `{"to": "merchant", "amount": 60}`,
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"id":7,"account":"jc","amount":100,"currency":"USD","status":"captured","captured":60,"transaction_id":42,"expires_at":"2026-10-25T12:00:00Z","created_at":null}`,
+			expectedInput: account.CaptureHoldInput{HoldID: 7, ToAccount: "merchant", Amount: 60},
+			captureCalls:  1,
+		},
+		{
+			name:         "Invalid ID",
+			id:           "seven",
+			requestBody:  `{"to": "merchant"}`,
+			expectedCode: http.StatusBadRequest,
+			captureCalls: 0,
+		},
+		{
+			name:          "Exceeds hold",
+			id:            "7",
+			requestBody:   `{"to": "merchant", "amount": 160}`,
+			expectedCode:  http.StatusUnprocessableEntity,
+			expectedBody:  `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"capture exceeds held amount","instance":"/holds/7/capture","code":"capture_exceeds_hold"}`,
+			expectedInput: account.CaptureHoldInput{HoldID: 7, ToAccount: "merchant", Amount: 160},
+			mockError:     domain.ErrCaptureExceedsHold,
+			captureCalls:  1,
+		},
+		{
+			name:          "Already captured",
+			id:            "7",
+			requestBody:   `{"to": "merchant"}`,
+			expectedCode:  http.StatusConflict,
+			expectedBody:  `{"type":"about:blank","title":"Conflict","status":409,"detail":"hold is not active","instance":"/holds/7/capture","code":"hold_not_active"}`,
+			expectedInput: account.CaptureHoldInput{HoldID: 7, ToAccount: "merchant"},
+			mockError:     domain.ErrHoldNotActive,
+			captureCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.CaptureHoldUseCaseMock{
+				CaptureHoldFunc: func(ctx context.Context, input account.CaptureHoldInput) (*entities.Hold, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Hold{
+						ID:            input.HoldID,
+						Account:       "jc",
+						Amount:        100,
+						Currency:      entities.Currency{Code: "USD", Exponent: 2},
+						Status:        entities.HoldCaptured,
+						Captured:      input.Amount,
+						TransactionID: 42,
+						ExpiresAt:     time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC),
+					}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest(http.MethodPost, "/holds/"+tt.id+"/capture", strings.NewReader(tt.requestBody))
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.CaptureHoldHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.CaptureHoldCalls()
+			assert.Len(t, calls, tt.captureCalls)
+			if tt.captureCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestReleaseHoldHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		expectedCode int
+		expectedBody string
+		mockError    error
+		releaseCalls int
+	}{
+		{
+			name:         "Released",
+			id:           "7",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"id":7,"account":"jc","amount":100,"currency":"USD","status":"released","expires_at":"2026-10-25T12:00:00Z","created_at":null}`,
+			releaseCalls: 1,
+		},
+		{
+			name:         "Not found",
+			id:           "8",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"hold not found","instance":"/holds/8/release","code":"hold_not_found"}`,
+			mockError:    domain.ErrHoldNotFound,
+			releaseCalls: 1,
+		},
+		{
+			name:         "Expired",
+			id:           "9",
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"type":"about:blank","title":"Conflict","status":409,"detail":"hold expired","instance":"/holds/9/release","code":"hold_expired"}`,
+			mockError:    domain.ErrHoldExpired,
+			releaseCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.ReleaseHoldUseCaseMock{
+				ReleaseHoldFunc: func(ctx context.Context, id int64) (*entities.Hold, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Hold{
+						ID:        id,
+						Account:   "jc",
+						Amount:    100,
+						Currency:  entities.Currency{Code: "USD", Exponent: 2},
+						Status:    entities.HoldReleased,
+						ExpiresAt: time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC),
+					}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest(http.MethodPost, "/holds/"+tt.id+"/release", nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.ReleaseHoldHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			assert.Len(t, mockUseCase.ReleaseHoldCalls(), tt.releaseCalls)
+		})
+	}
+}
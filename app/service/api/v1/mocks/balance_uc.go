@@ -6,6 +6,8 @@ package mocks
 import (
 	"context"
 	"github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
 	"sync"
 )
 
@@ -19,7 +21,7 @@ var _ v1.BalanceUseCase = &BalanceUseCaseMock{}
 //
 //		// make and configure a mocked v1.BalanceUseCase
 //		mockedBalanceUseCase := &BalanceUseCaseMock{
-//			BalanceFunc: func(ctx context.Context, account string) (int64, error) {
+//			BalanceFunc: func(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error) {
 //				panic("mock out the Balance method")
 //			},
 //		}
@@ -30,7 +32,7 @@ var _ v1.BalanceUseCase = &BalanceUseCaseMock{}
 //	}
 type BalanceUseCaseMock struct {
 	// BalanceFunc mocks the Balance method.
-	BalanceFunc func(ctx context.Context, account string) (int64, error)
+	BalanceFunc func(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -38,33 +40,33 @@ type BalanceUseCaseMock struct {
 		Balance []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// Account is the account argument value.
-			Account string
+			// Input is the input argument value.
+			Input account.BalanceInput
 		}
 	}
 	lockBalance sync.RWMutex
 }
 
 // Balance calls BalanceFunc.
-func (mock *BalanceUseCaseMock) Balance(ctx context.Context, account string) (int64, error) {
+func (mock *BalanceUseCaseMock) Balance(ctx context.Context, input account.BalanceInput) ([]entities.Balance, error) {
 	callInfo := struct {
-		Ctx     context.Context
-		Account string
+		Ctx   context.Context
+		Input account.BalanceInput
 	}{
-		Ctx:     ctx,
-		Account: account,
+		Ctx:   ctx,
+		Input: input,
 	}
 	mock.lockBalance.Lock()
 	mock.calls.Balance = append(mock.calls.Balance, callInfo)
 	mock.lockBalance.Unlock()
 	if mock.BalanceFunc == nil {
 		var (
-			nOut   int64
-			errOut error
+			balancesOut []entities.Balance
+			errOut      error
 		)
-		return nOut, errOut
+		return balancesOut, errOut
 	}
-	return mock.BalanceFunc(ctx, account)
+	return mock.BalanceFunc(ctx, input)
 }
 
 // BalanceCalls gets all the calls that were made to Balance.
@@ -72,12 +74,12 @@ func (mock *BalanceUseCaseMock) Balance(ctx context.Context, account string) (in
 //
 //	len(mockedBalanceUseCase.BalanceCalls())
 func (mock *BalanceUseCaseMock) BalanceCalls() []struct {
-	Ctx     context.Context
-	Account string
+	Ctx   context.Context
+	Input account.BalanceInput
 } {
 	var calls []struct {
-		Ctx     context.Context
-		Account string
+		Ctx   context.Context
+		Input account.BalanceInput
 	}
 	mock.lockBalance.RLock()
 	calls = mock.calls.Balance
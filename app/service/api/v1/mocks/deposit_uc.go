@@ -20,7 +20,7 @@ var _ v1.DepositUseCase = &DepositUseCaseMock{}
 //
 //		// make and configure a mocked v1.DepositUseCase
 //		mockedDepositUseCase := &DepositUseCaseMock{
-//			DepositFunc: func(ctx context.Context, input account.DepositInput) error {
+//			DepositFunc: func(ctx context.Context, input account.DepositInput) (int64, error) {
 //				panic("mock out the Deposit method")
 //			},
 //		}
@@ -31,7 +31,7 @@ var _ v1.DepositUseCase = &DepositUseCaseMock{}
 //	}
 type DepositUseCaseMock struct {
 	// DepositFunc mocks the Deposit method.
-	DepositFunc func(ctx context.Context, input account.DepositInput) error
+	DepositFunc func(ctx context.Context, input account.DepositInput) (int64, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -47,7 +47,7 @@ type DepositUseCaseMock struct {
 }
 
 // Deposit calls DepositFunc.
-func (mock *DepositUseCaseMock) Deposit(ctx context.Context, input account.DepositInput) error {
+func (mock *DepositUseCaseMock) Deposit(ctx context.Context, input account.DepositInput) (int64, error) {
 	callInfo := struct {
 		Ctx   context.Context
 		Input account.DepositInput
@@ -60,9 +60,10 @@ func (mock *DepositUseCaseMock) Deposit(ctx context.Context, input account.Depos
 	mock.lockDeposit.Unlock()
 	if mock.DepositFunc == nil {
 		var (
+			nOut   int64
 			errOut error
 		)
-		return errOut
+		return nOut, errOut
 	}
 	return mock.DepositFunc(ctx, input)
 }
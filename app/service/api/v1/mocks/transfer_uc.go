@@ -20,7 +20,7 @@ var _ v1.TransferUseCase = &TransferUseCaseMock{}
 //
 //		// make and configure a mocked v1.TransferUseCase
 //		mockedTransferUseCase := &TransferUseCaseMock{
-//			TransferFunc: func(ctx context.Context, input account.TransferInput) error {
+//			TransferFunc: func(ctx context.Context, input account.TransferInput) (int64, error) {
 //				panic("mock out the Transfer method")
 //			},
 //		}
@@ -31,7 +31,7 @@ var _ v1.TransferUseCase = &TransferUseCaseMock{}
 //	}
 type TransferUseCaseMock struct {
 	// TransferFunc mocks the Transfer method.
-	TransferFunc func(ctx context.Context, input account.TransferInput) error
+	TransferFunc func(ctx context.Context, input account.TransferInput) (int64, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -47,7 +47,7 @@ type TransferUseCaseMock struct {
 }
 
 // Transfer calls TransferFunc.
-func (mock *TransferUseCaseMock) Transfer(ctx context.Context, input account.TransferInput) error {
+func (mock *TransferUseCaseMock) Transfer(ctx context.Context, input account.TransferInput) (int64, error) {
 	callInfo := struct {
 		Ctx   context.Context
 		Input account.TransferInput
@@ -60,9 +60,10 @@ func (mock *TransferUseCaseMock) Transfer(ctx context.Context, input account.Tra
 	mock.lockTransfer.Unlock()
 	if mock.TransferFunc == nil {
 		var (
+			nOut   int64
 			errOut error
 		)
-		return errOut
+		return nOut, errOut
 	}
 	return mock.TransferFunc(ctx, input)
 }
@@ -20,7 +20,7 @@ var _ v1.TransfersHistoryUseCase = &TransfersHistoryUseCaseMock{}
 //
 //		// make and configure a mocked v1.TransfersHistoryUseCase
 //		mockedTransfersHistoryUseCase := &TransfersHistoryUseCaseMock{
-//			TransfersHistoryFunc: func(ctx context.Context, accountMoqParam string) (*account.TransfersHistoryOutput, error) {
+//			TransfersHistoryFunc: func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
 //				panic("mock out the TransfersHistory method")
 //			},
 //		}
@@ -31,7 +31,7 @@ var _ v1.TransfersHistoryUseCase = &TransfersHistoryUseCaseMock{}
 //	}
 type TransfersHistoryUseCaseMock struct {
 	// TransfersHistoryFunc mocks the TransfersHistory method.
-	TransfersHistoryFunc func(ctx context.Context, accountMoqParam string) (*account.TransfersHistoryOutput, error)
+	TransfersHistoryFunc func(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -39,21 +39,21 @@ type TransfersHistoryUseCaseMock struct {
 		TransfersHistory []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// AccountMoqParam is the accountMoqParam argument value.
-			AccountMoqParam string
+			// Input is the input argument value.
+			Input account.TransfersHistoryInput
 		}
 	}
 	lockTransfersHistory sync.RWMutex
 }
 
 // TransfersHistory calls TransfersHistoryFunc.
-func (mock *TransfersHistoryUseCaseMock) TransfersHistory(ctx context.Context, accountMoqParam string) (*account.TransfersHistoryOutput, error) {
+func (mock *TransfersHistoryUseCaseMock) TransfersHistory(ctx context.Context, input account.TransfersHistoryInput) (*account.TransfersHistoryOutput, error) {
 	callInfo := struct {
-		Ctx             context.Context
-		AccountMoqParam string
+		Ctx   context.Context
+		Input account.TransfersHistoryInput
 	}{
-		Ctx:             ctx,
-		AccountMoqParam: accountMoqParam,
+		Ctx:   ctx,
+		Input: input,
 	}
 	mock.lockTransfersHistory.Lock()
 	mock.calls.TransfersHistory = append(mock.calls.TransfersHistory, callInfo)
@@ -65,7 +65,7 @@ func (mock *TransfersHistoryUseCaseMock) TransfersHistory(ctx context.Context, a
 		)
 		return transfersHistoryOutputOut, errOut
 	}
-	return mock.TransfersHistoryFunc(ctx, accountMoqParam)
+	return mock.TransfersHistoryFunc(ctx, input)
 }
 
 // TransfersHistoryCalls gets all the calls that were made to TransfersHistory.
@@ -73,12 +73,12 @@ func (mock *TransfersHistoryUseCaseMock) TransfersHistory(ctx context.Context, a
 //
 //	len(mockedTransfersHistoryUseCase.TransfersHistoryCalls())
 func (mock *TransfersHistoryUseCaseMock) TransfersHistoryCalls() []struct {
-	Ctx             context.Context
-	AccountMoqParam string
+	Ctx   context.Context
+	Input account.TransfersHistoryInput
 } {
 	var calls []struct {
-		Ctx             context.Context
-		AccountMoqParam string
+		Ctx   context.Context
+		Input account.TransfersHistoryInput
 	}
 	mock.lockTransfersHistory.RLock()
 	calls = mock.calls.TransfersHistory
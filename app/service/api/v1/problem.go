@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/julioc98/ledger/domain"
+)
+
+// ProblemContentType is the media type of error responses.
+const ProblemContentType = "application/problem+json"
+
+// Error codes returned in the code member of a Problem. They are part of the
+// API contract and must not change.
+const (
+	CodeInvalidRequest         = "invalid_request"
+	CodeInternalError          = "internal_error"
+	CodeInsufficientFunds      = "insufficient_funds"
+	CodeInvalidDirection       = "invalid_direction"
+	CodeAmountsNotMatch        = "amounts_not_match"
+	CodeCurrenciesNotMatch     = "currencies_not_match"
+	CodeInvalidCurrency        = "invalid_currency"
+	CodeUnbalancedJournalEntry = "unbalanced_journal_entry"
+	CodeJournalEntryLegs       = "journal_entry_legs"
+	CodeIdempotencyKeyReused   = "idempotency_key_reused"
+	CodeTransactionNotFound    = "transaction_not_found"
+	CodeTransactionNotPending  = "transaction_not_pending"
+	CodeTransactionNotPosted   = "transaction_not_posted"
+	CodeAlreadyReversed        = "transaction_already_reversed"
+	CodeReversalExceeds        = "reversal_exceeds_transaction"
+	CodePartialReversalLegs    = "partial_reversal_legs"
+	CodeInvalidAmount          = "invalid_amount"
+	CodeEmptyAccount           = "empty_account"
+	CodeInvalidAccount         = "invalid_account"
+	CodeSelfTransfer           = "self_transfer"
+	CodeInvalidCursor          = "invalid_cursor"
+	CodeInvalidPageLimit       = "invalid_page_limit"
+	CodeAccountNotFound        = "account_not_found"
+	CodeAccountAlreadyExists   = "account_already_exists"
+	CodeAccountFrozen          = "account_frozen"
+	CodeAccountClosed          = "account_closed"
+	CodeInvalidAccountStatus   = "invalid_account_status"
+	CodeInvalidAccountType     = "invalid_account_type"
+	CodeInvalidOverdraftLimit  = "invalid_overdraft_limit"
+	CodeParentNotFound         = "parent_not_found"
+	CodeInvalidParent          = "invalid_parent"
+	CodeInvalidTransition      = "invalid_status_transition"
+	CodeHoldNotFound           = "hold_not_found"
+	CodeHoldNotActive          = "hold_not_active"
+	CodeHoldExpired            = "hold_expired"
+	CodeInvalidHoldExpiry      = "invalid_hold_expiry"
+	CodeCaptureExceedsHold     = "capture_exceeds_hold"
+	CodeWebhookNotFound        = "webhook_not_found"
+	CodeInvalidWebhookURL      = "invalid_webhook_url"
+)
+
+// Problem is an RFC 7807 problem details error response.
+type Problem struct {
+	// URI reference identifying the problem type
+	Type string `json:"type" example:"about:blank"`
+	// Short summary of the problem type
+	Title string `json:"title" example:"Payment Required"`
+	// HTTP status code
+	Status int `json:"status" example:"402"`
+	// Explanation specific to this occurrence of the problem
+	Detail string `json:"detail,omitempty" example:"insufficient funds"`
+	// Request path where the problem occurred
+	Instance string `json:"instance,omitempty" example:"/api/v1/account/123/transfers"`
+	// Stable machine-readable error code
+	Code string `json:"code" enums:"invalid_request,internal_error,insufficient_funds,invalid_direction,amounts_not_match,currencies_not_match,invalid_currency,unbalanced_journal_entry,journal_entry_legs,idempotency_key_reused,transaction_not_found,transaction_not_pending,transaction_not_posted,transaction_already_reversed,reversal_exceeds_transaction,partial_reversal_legs,invalid_amount,empty_account,invalid_account,self_transfer,invalid_cursor,invalid_page_limit,account_not_found,account_already_exists,account_frozen,account_closed,invalid_account_status,invalid_account_type,invalid_overdraft_limit,parent_not_found,invalid_parent,invalid_status_transition,hold_not_found,hold_not_active,hold_expired,invalid_hold_expiry,capture_exceeds_hold,webhook_not_found,invalid_webhook_url"`
+}
+
+// problems maps domain errors to their status and code. Errors are matched
+// with errors.Is, in order.
+var problems = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{domain.ErrInsufficientFunds, http.StatusPaymentRequired, CodeInsufficientFunds},
+	{domain.ErrIvalidDirection, http.StatusBadRequest, CodeInvalidDirection},
+	{domain.ErrAmountsNotMatch, http.StatusBadRequest, CodeAmountsNotMatch},
+	{domain.ErrCurrenciesNotMatch, http.StatusBadRequest, CodeCurrenciesNotMatch},
+	{domain.ErrInvalidCurrency, http.StatusBadRequest, CodeInvalidCurrency},
+	{domain.ErrUnbalancedJournalEntry, http.StatusBadRequest, CodeUnbalancedJournalEntry},
+	{domain.ErrJournalEntryLegs, http.StatusBadRequest, CodeJournalEntryLegs},
+	{domain.ErrIdempotencyKeyReused, http.StatusConflict, CodeIdempotencyKeyReused},
+	{domain.ErrTransactionNotFound, http.StatusNotFound, CodeTransactionNotFound},
+	{domain.ErrTransactionNotPending, http.StatusConflict, CodeTransactionNotPending},
+	{domain.ErrTransactionNotPosted, http.StatusConflict, CodeTransactionNotPosted},
+	{domain.ErrTransactionAlreadyReversed, http.StatusConflict, CodeAlreadyReversed},
+	{domain.ErrReversalExceedsTransaction, http.StatusUnprocessableEntity, CodeReversalExceeds},
+	{domain.ErrPartialReversalLegs, http.StatusUnprocessableEntity, CodePartialReversalLegs},
+	{domain.ErrInvalidAmount, http.StatusUnprocessableEntity, CodeInvalidAmount},
+	{domain.ErrEmptyAccount, http.StatusUnprocessableEntity, CodeEmptyAccount},
+	{domain.ErrInvalidAccount, http.StatusUnprocessableEntity, CodeInvalidAccount},
+	{domain.ErrSelfTransfer, http.StatusUnprocessableEntity, CodeSelfTransfer},
+	{domain.ErrInvalidCursor, http.StatusBadRequest, CodeInvalidCursor},
+	{domain.ErrInvalidPageLimit, http.StatusBadRequest, CodeInvalidPageLimit},
+	{domain.ErrAccountNotFound, http.StatusNotFound, CodeAccountNotFound},
+	{domain.ErrAccountAlreadyExists, http.StatusConflict, CodeAccountAlreadyExists},
+	{domain.ErrAccountFrozen, http.StatusConflict, CodeAccountFrozen},
+	{domain.ErrAccountClosed, http.StatusConflict, CodeAccountClosed},
+	{domain.ErrInvalidAccountStatus, http.StatusBadRequest, CodeInvalidAccountStatus},
+	{domain.ErrInvalidAccountType, http.StatusBadRequest, CodeInvalidAccountType},
+	{domain.ErrInvalidOverdraftLimit, http.StatusUnprocessableEntity, CodeInvalidOverdraftLimit},
+	{domain.ErrParentNotFound, http.StatusUnprocessableEntity, CodeParentNotFound},
+	{domain.ErrInvalidParent, http.StatusUnprocessableEntity, CodeInvalidParent},
+	{domain.ErrInvalidStatusTransition, http.StatusConflict, CodeInvalidTransition},
+	{domain.ErrHoldNotFound, http.StatusNotFound, CodeHoldNotFound},
+	{domain.ErrHoldNotActive, http.StatusConflict, CodeHoldNotActive},
+	{domain.ErrHoldExpired, http.StatusConflict, CodeHoldExpired},
+	{domain.ErrInvalidHoldExpiry, http.StatusUnprocessableEntity, CodeInvalidHoldExpiry},
+	{domain.ErrCaptureExceedsHold, http.StatusUnprocessableEntity, CodeCaptureExceedsHold},
+	{domain.ErrWebhookNotFound, http.StatusNotFound, CodeWebhookNotFound},
+	{domain.ErrInvalidWebhookURL, http.StatusUnprocessableEntity, CodeInvalidWebhookURL},
+}
+
+// ProblemOf returns the status and code of the problem reporting err, or
+// false if err is unknown to the mapping.
+func ProblemOf(err error) (status int, code string, ok bool) {
+	for _, p := range problems {
+		if errors.Is(err, p.err) {
+			return p.status, p.code, true
+		}
+	}
+
+	return 0, "", false
+}
+
+// writeError writes err as a problem response. Errors unknown to the mapping
+// are reported as internal errors without leaking their message.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if status, code, ok := ProblemOf(err); ok {
+		writeProblem(w, r, status, code, err.Error())
+		return
+	}
+
+	writeProblem(w, r, http.StatusInternalServerError, CodeInternalError, "")
+}
+
+// writeProblem writes a problem response with the given status and code.
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, code, detail string) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Code:     code,
+	})
+}
@@ -0,0 +1,110 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblemResponses(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		useCaseError   error
+		expectedStatus int
+		expectedCode   string
+		expectedDetail string
+	}{
+		{
+			name:           "Malformed payload",
+			requestBody:    `{"to": `,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   v1.CodeInvalidRequest,
+			expectedDetail: "unexpected EOF",
+		},
+		{
+			name:           "Insufficient funds",
+			useCaseError:   domain.ErrInsufficientFunds,
+			expectedStatus: http.StatusPaymentRequired,
+			expectedCode:   v1.CodeInsufficientFunds,
+			expectedDetail: "insufficient funds",
+		},
+		{
+			name:           "Amounts not match",
+			useCaseError:   domain.ErrAmountsNotMatch,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   v1.CodeAmountsNotMatch,
+			expectedDetail: "debit and credit amounts must match",
+		},
+		{
+			name:           "Invalid amount",
+			useCaseError:   domain.ErrInvalidAmount,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   v1.CodeInvalidAmount,
+			expectedDetail: "amount must be positive",
+		},
+		{
+			name:           "Self transfer",
+			useCaseError:   domain.ErrSelfTransfer,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   v1.CodeSelfTransfer,
+			expectedDetail: "cannot transfer to the same account",
+		},
+		{
+			name:           "Idempotency key reused",
+			useCaseError:   domain.ErrIdempotencyKeyReused,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   v1.CodeIdempotencyKeyReused,
+			expectedDetail: "idempotency key already used with a different request",
+		},
+		{
+			name:           "Unknown errors do not leak",
+			useCaseError:   assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   v1.CodeInternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTransferUC := &mocks.TransferUseCaseMock{
+				TransferFunc: func(ctx context.Context, input account.TransferInput) (int64, error) {
+					return 0, tt.useCaseError
+				},
+			}
+
+			body := tt.requestBody
+			if body == "" {
+				body = `{"to": "destination-account", "amount": 100}`
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/account/source-account/transfers", strings.NewReader(body))
+			w := httptest.NewRecorder()
+			v1.CreateTransferHandler(mockTransferUC)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, v1.ProblemContentType, w.Header().Get("Content-Type"))
+
+			var problem v1.Problem
+			err := json.NewDecoder(w.Body).Decode(&problem)
+			assert.NoError(t, err)
+
+			assert.Equal(t, v1.Problem{
+				Type:     "about:blank",
+				Title:    http.StatusText(tt.expectedStatus),
+				Status:   tt.expectedStatus,
+				Detail:   tt.expectedDetail,
+				Instance: "/api/v1/account/source-account/transfers",
+				Code:     tt.expectedCode,
+			}, problem)
+		})
+	}
+}
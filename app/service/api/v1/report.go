@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// TrialBalanceLine is the sum of the posted debits and credits of an account
+// in a single currency.
+type TrialBalanceLine struct {
+	Account string `json:"account"`
+	// ISO 4217 currency code
+	Currency string `json:"currency"`
+	// Debits in minor units
+	Debits int64 `json:"debits"`
+	// Credits in minor units
+	Credits int64 `json:"credits"`
+}
+
+// TrialBalanceTotal is the sum of the debits and credits of every account in
+// a single currency.
+type TrialBalanceTotal struct {
+	// ISO 4217 currency code
+	Currency string `json:"currency"`
+	Debits   int64  `json:"debits"`
+	Credits  int64  `json:"credits"`
+	// Whether the debits equal the credits
+	Balanced bool `json:"balanced"`
+}
+
+type TrialBalanceResponse struct {
+	// Whether the debits equal the credits in every currency
+	Balanced bool                `json:"balanced"`
+	Accounts []TrialBalanceLine  `json:"accounts"`
+	Totals   []TrialBalanceTotal `json:"totals"`
+}
+
+func newTrialBalanceResponse(tb *entities.TrialBalance) TrialBalanceResponse {
+	resp := TrialBalanceResponse{
+		Balanced: tb.Balanced(),
+		Accounts: make([]TrialBalanceLine, 0, len(tb.Lines)),
+		Totals:   make([]TrialBalanceTotal, 0, len(tb.Totals)),
+	}
+	for _, l := range tb.Lines {
+		resp.Accounts = append(resp.Accounts, TrialBalanceLine{
+			Account:  l.Account,
+			Currency: l.Currency.Code,
+			Debits:   l.Debits,
+			Credits:  l.Credits,
+		})
+	}
+	for _, t := range tb.Totals {
+		resp.Totals = append(resp.Totals, TrialBalanceTotal{
+			Currency: t.Currency.Code,
+			Debits:   t.Debits,
+			Credits:  t.Credits,
+			Balanced: t.Balanced(),
+		})
+	}
+
+	return resp
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/trial_balance_uc.go . TrialBalanceUseCase
+type TrialBalanceUseCase interface {
+	TrialBalance(ctx context.Context, asOf *time.Time) (*entities.TrialBalance, error)
+}
+
+// GetTrialBalanceHandler godoc
+// @Summary      Get the trial balance
+// @Description  List the posted debit and credit totals of every account, now or at a point in time, with the totals of each currency, whose debits must equal their credits
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        as_of query string false "RFC 3339 time to compute the trial balance at, defaults to now"
+// @Success      200 {object} TrialBalanceResponse "Trial balance retrieved successfully"
+// @Failure      400 {object} Problem "Invalid as_of time"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/reports/trial-balance [get]
+func GetTrialBalanceHandler(uc TrialBalanceUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		asOf, err := queryTime(r.URL.Query().Get("as_of"))
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid as_of: "+err.Error())
+			return
+		}
+
+		tb, err := uc.TrialBalance(r.Context(), asOf)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newTrialBalanceResponse(tb))
+	}
+}
+
+// UnbalancedTransaction is a transaction whose debits and credits in a
+// currency differ.
+type UnbalancedTransaction struct {
+	TransactionID int64 `json:"transaction_id"`
+	// ISO 4217 currency code
+	Currency string `json:"currency"`
+	Debits   int64  `json:"debits"`
+	Credits  int64  `json:"credits"`
+}
+
+type UnbalancedTransactionsResponse struct {
+	// Whether every transaction's legs net to zero
+	Balanced     bool                    `json:"balanced"`
+	Transactions []UnbalancedTransaction `json:"transactions"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/unbalanced_transactions_uc.go . UnbalancedTransactionsUseCase
+type UnbalancedTransactionsUseCase interface {
+	UnbalancedTransactions(ctx context.Context) ([]entities.UnbalancedTransaction, error)
+}
+
+// GetUnbalancedTransactionsHandler godoc
+// @Summary      Check the double-entry invariant
+// @Description  List the transactions, whatever their status, whose legs do not net to zero in some currency
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} UnbalancedTransactionsResponse "Invariant checked"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/reports/unbalanced-transactions [get]
+func GetUnbalancedTransactionsHandler(uc UnbalancedTransactionsUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		unbalanced, err := uc.UnbalancedTransactions(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := UnbalancedTransactionsResponse{
+			Balanced:     len(unbalanced) == 0,
+			Transactions: make([]UnbalancedTransaction, 0, len(unbalanced)),
+		}
+		for _, u := range unbalanced {
+			resp.Transactions = append(resp.Transactions, UnbalancedTransaction{
+				TransactionID: u.TransactionID,
+				Currency:      u.Currency.Code,
+				Debits:        u.Debits,
+				Credits:       u.Credits,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
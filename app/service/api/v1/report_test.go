@@ -0,0 +1,158 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTrialBalanceHandler(t *testing.T) {
+	usd := entities.Currency{Code: "USD", Exponent: 2}
+	asOf := time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		query         string
+		trialBalance  entities.TrialBalance
+		mockError     error
+		expectedCode  int
+		expectedBody  string
+		expectedAsOf  *time.Time
+		trialBalCalls int
+	}{
+		{
+			name: "Balanced",
+			trialBalance: entities.NewTrialBalance([]entities.TrialBalanceLine{
+				{Account: "account1", Currency: usd, Debits: 100, Credits: 40},
+				{Account: "account2", Currency: usd, Debits: 40},
+				{Account: "external", Currency: usd, Credits: 100},
+			}),
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balanced":true,"accounts":[` +
+				`{"account":"account1","currency":"USD","debits":100,"credits":40},` +
+				`{"account":"account2","currency":"USD","debits":40,"credits":0},` +
+				`{"account":"external","currency":"USD","debits":0,"credits":100}` +
+				`],"totals":[{"currency":"USD","debits":140,"credits":140,"balanced":true}]}`,
+			trialBalCalls: 1,
+		},
+		{
+			name:  "As of",
+			query: "?as_of=2026-09-30T23:59:59Z",
+			trialBalance: entities.NewTrialBalance([]entities.TrialBalanceLine{
+				{Account: "account1", Currency: usd, Debits: 100},
+				{Account: "external", Currency: usd, Credits: 90},
+			}),
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balanced":false,"accounts":[` +
+				`{"account":"account1","currency":"USD","debits":100,"credits":0},` +
+				`{"account":"external","currency":"USD","debits":0,"credits":90}` +
+				`],"totals":[{"currency":"USD","debits":100,"credits":90,"balanced":false}]}`,
+			expectedAsOf:  &asOf,
+			trialBalCalls: 1,
+		},
+		{
+			name:          "Empty ledger",
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"balanced":true,"accounts":[],"totals":[]}`,
+			trialBalCalls: 1,
+		},
+		{
+			name:          "Invalid as_of",
+			query:         "?as_of=yesterday",
+			expectedCode:  http.StatusBadRequest,
+			trialBalCalls: 0,
+		},
+		{
+			name:          "Internal error",
+			mockError:     assert.AnError,
+			expectedCode:  http.StatusInternalServerError,
+			expectedBody:  `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/reports/trial-balance","code":"internal_error"}`,
+			trialBalCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.TrialBalanceUseCaseMock{
+				TrialBalanceFunc: func(ctx context.Context, asOf *time.Time) (*entities.TrialBalance, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &tt.trialBalance, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/reports/trial-balance"+tt.query, nil)
+			w := httptest.NewRecorder()
+			v1.GetTrialBalanceHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.TrialBalanceCalls()
+			assert.Len(t, calls, tt.trialBalCalls)
+			if tt.trialBalCalls > 0 {
+				assert.Equal(t, tt.expectedAsOf, calls[0].AsOf)
+			}
+		})
+	}
+}
+
+func TestGetUnbalancedTransactionsHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		unbalanced   []entities.UnbalancedTransaction
+		mockError    error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Invariant holds",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balanced":true,"transactions":[]}`,
+		},
+		{
+			name: "Unbalanced transaction",
+			unbalanced: []entities.UnbalancedTransaction{
+				{TransactionID: 7, Currency: entities.Currency{Code: "USD", Exponent: 2}, Debits: 30},
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"balanced":false,"transactions":[{"transaction_id":7,"currency":"USD","debits":30,"credits":0}]}`,
+		},
+		{
+			name:         "Internal error",
+			mockError:    assert.AnError,
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/reports/unbalanced-transactions","code":"internal_error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.UnbalancedTransactionsUseCaseMock{
+				UnbalancedTransactionsFunc: func(ctx context.Context) ([]entities.UnbalancedTransaction, error) {
+					return tt.unbalanced, tt.mockError
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/reports/unbalanced-transactions", nil)
+			w := httptest.NewRecorder()
+			v1.GetUnbalancedTransactionsHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			assert.Len(t, mockUseCase.UnbalancedTransactionsCalls(), 1)
+		})
+	}
+}
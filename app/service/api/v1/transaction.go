@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+type CreateTransactionLeg struct {
+	Account   string             `json:"account"`
+	Direction entities.Direction `json:"direction"`
+	Amount    int64              `json:"amount"`
+}
+
+type CreateTransactionRequest struct {
+	// ISO 4217 currency code of every leg, defaults to USD
+	Currency string                 `json:"currency"`
+	Legs     []CreateTransactionLeg `json:"legs"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/journal_uc.go . JournalUseCase
+type JournalUseCase interface {
+	Journal(ctx context.Context, input account.JournalInput) (int64, error)
+}
+
+// CreateTransactionHandler godoc
+// @Summary      Create a transaction
+// @Description  Create a transaction with any number of legs whose debits sum to its credits
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param request body CreateTransactionRequest true "Transaction legs"
+// @Success      201 {object} TransactionCreatedResponse "Transaction created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      402 {object} Problem "Insufficient funds"
+// @Failure      422 {object} Problem "Invalid amount or account"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/transactions [post]
+func CreateTransactionHandler(uc JournalUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateTransactionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.JournalInput{
+			Currency: currencyOrDefault(req.Currency),
+			Legs:     make([]account.JournalLegInput, 0, len(req.Legs)),
+		}
+		for _, leg := range req.Legs {
+			input.Legs = append(input.Legs, account.JournalLegInput{
+				Account:   leg.Account,
+				Direction: leg.Direction,
+				Amount:    leg.Amount,
+			})
+		}
+
+		transactionID, err := uc.Journal(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(TransactionCreatedResponse{transactionID})
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/transaction_uc.go . TransactionUseCase
+type TransactionUseCase interface {
+	Transaction(ctx context.Context, id int64) (*entities.Transaction, error)
+}
+
+// GetTransactionHandler godoc
+// @Summary      Get a transaction
+// @Description  Get a transaction with all of its entries
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Transaction ID"
+// @Success      200 {object} entities.Transaction "Transaction retrieved successfully"
+// @Failure      400 {object} Problem "Invalid transaction ID"
+// @Failure      404 {object} Problem "Transaction not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/transactions/{id} [get]
+func GetTransactionHandler(uc TransactionUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid transaction ID")
+			return
+		}
+
+		transaction, err := uc.Transaction(r.Context(), id)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(transaction)
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/post_transaction_uc.go . PostTransactionUseCase
+type PostTransactionUseCase interface {
+	PostTransaction(ctx context.Context, id int64) (*entities.Transaction, error)
+}
+
+// PostTransactionHandler godoc
+// @Summary      Post a pending transaction
+// @Description  Make a pending transaction final, moving the funds it reserved
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Transaction ID"
+// @Success      200 {object} entities.Transaction "Transaction posted successfully"
+// @Failure      400 {object} Problem "Invalid transaction ID"
+// @Failure      404 {object} Problem "Transaction not found"
+// @Failure      409 {object} Problem "Transaction not pending or account frozen or closed"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/transactions/{id}/post [post]
+func PostTransactionHandler(uc PostTransactionUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid transaction ID")
+			return
+		}
+
+		transaction, err := uc.PostTransaction(r.Context(), id)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(transaction)
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/void_transaction_uc.go . VoidTransactionUseCase
+type VoidTransactionUseCase interface {
+	VoidTransaction(ctx context.Context, id int64) (*entities.Transaction, error)
+}
+
+// VoidTransactionHandler godoc
+// @Summary      Void a pending transaction
+// @Description  Cancel a pending transaction, making the funds it reserved available again
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Transaction ID"
+// @Success      200 {object} entities.Transaction "Transaction voided successfully"
+// @Failure      400 {object} Problem "Invalid transaction ID"
+// @Failure      404 {object} Problem "Transaction not found"
+// @Failure      409 {object} Problem "Transaction not pending"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/transactions/{id}/void [post]
+func VoidTransactionHandler(uc VoidTransactionUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid transaction ID")
+			return
+		}
+
+		transaction, err := uc.VoidTransaction(r.Context(), id)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(transaction)
+	}
+}
+
+type ReverseTransactionRequest struct {
+	// Amount to reverse, all that is left of the transaction when omitted.
+	// Only transactions with two legs can be partially reversed.
+	Amount int64 `json:"amount,omitempty"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/reverse_transaction_uc.go . ReverseTransactionUseCase
+type ReverseTransactionUseCase interface {
+	ReverseTransaction(ctx context.Context, input account.ReverseTransactionInput) (int64, error)
+}
+
+// ReverseTransactionHandler godoc
+// @Summary      Reverse a posted transaction
+// @Description  Book a transaction with the opposite entries of a posted one, linked to it through ReversalOf. The original transaction is left untouched. The request body is optional; without an amount all that is left of the transaction is reversed.
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Transaction ID"
+// @Param request body ReverseTransactionRequest false "Reversal details"
+// @Success      201 {object} TransactionCreatedResponse "Reversal created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      402 {object} Problem "Insufficient funds"
+// @Failure      404 {object} Problem "Transaction not found"
+// @Failure      409 {object} Problem "Transaction not posted or already reversed"
+// @Failure      422 {object} Problem "Invalid amount or amount exceeds the transaction"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/transactions/{id}/reverse [post]
+func ReverseTransactionHandler(uc ReverseTransactionUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid transaction ID")
+			return
+		}
+
+		var req ReverseTransactionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.ReverseTransactionInput{
+			TransactionID: id,
+			Amount:        req.Amount,
+		}
+		transactionID, err := uc.ReverseTransaction(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(TransactionCreatedResponse{transactionID})
+	}
+}
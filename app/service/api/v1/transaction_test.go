@@ -0,0 +1,391 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTransactionHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+		mockError    error
+		journalCalls int
+	}{
+		{
+			name:         "Valid request",
+			requestBody:  `{"currency": "EUR", "legs": [{"account": "payer", "direction": "credit", "amount": 100}, {"account": "merchant", "direction": "debit", "amount": 97}, {"account": "fees", "direction": "debit", "amount": 3}]}`,
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"transaction_id":1}`,
+			journalCalls: 1,
+		},
+		{
+			name:         "Invalid payload",
+			requestBody:  `{"legs": `,
+			expectedCode: http.StatusBadRequest,
+			journalCalls: 0,
+		},
+		{
+			name:         "Unbalanced legs",
+			requestBody:  `{"legs": [{"account": "payer", "direction": "credit", "amount": 100}, {"account": "merchant", "direction": "debit", "amount": 90}]}`,
+			expectedCode: http.StatusBadRequest,
+			mockError:    domain.ErrUnbalancedJournalEntry,
+			journalCalls: 1,
+		},
+		{
+			name:         "Non-positive amount",
+			requestBody:  `{"legs": [{"account": "payer", "direction": "credit", "amount": 0}, {"account": "merchant", "direction": "debit", "amount": 0}]}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			mockError:    domain.ErrInvalidAmount,
+			journalCalls: 1,
+		},
+		{
+			name:         "Insufficient funds",
+			requestBody:  `{"legs": [{"account": "payer", "direction": "credit", "amount": 100}, {"account": "merchant", "direction": "debit", "amount": 100}]}`,
+			expectedCode: http.StatusPaymentRequired,
+			mockError:    domain.ErrInsufficientFunds,
+			journalCalls: 1,
+		},
+		{
+			name:         "Internal server error",
+			requestBody:  `{"legs": [{"account": "payer", "direction": "credit", "amount": 100}, {"account": "merchant", "direction": "debit", "amount": 100}]}`,
+			expectedCode: http.StatusInternalServerError,
+			mockError:    assert.AnError,
+			journalCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.JournalUseCaseMock{
+				JournalFunc: func(ctx context.Context, input account.JournalInput) (int64, error) {
+					if tt.mockError != nil {
+						return 0, tt.mockError
+					}
+					return 1, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/transactions", strings.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+			v1.CreateTransactionHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.JournalCalls()
+			assert.Len(t, calls, tt.journalCalls)
+			if tt.name == "Valid request" {
+				assert.Equal(t, account.JournalInput{
+					Currency: "EUR",
+					Legs: []account.JournalLegInput{
+						{Account: "payer", Direction: entities.Credit, Amount: 100},
+						{Account: "merchant", Direction: entities.Debit, Amount: 97},
+						{Account: "fees", Direction: entities.Debit, Amount: 3},
+					},
+				}, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestGetTransactionHandler(t *testing.T) {
+	timeMock := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	tests := []struct {
+		name             string
+		id               string
+		expectedCode     int
+		expectedBody     string
+		useCaseReturn    *entities.Transaction
+		useCaseError     error
+		transactionCalls int
+	}{
+		{
+			name:         "Success",
+			id:           "7",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"ID":7,"Status":"posted","ReversalOf":0,"Entries":[` +
+				`{"ID":1,"TransactionID":7,"Account":"jc","Direction":"credit","Amount":100,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":"2023-12-05T01:56:57.324392Z"},` +
+				`{"ID":2,"TransactionID":7,"Account":"external","Direction":"debit","Amount":100,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":"2023-12-05T01:56:57.324392Z"}` +
+				`],"CreatedAt":"2023-12-05T01:56:57.324392Z"}`,
+			useCaseReturn: &entities.Transaction{
+				ID:     7,
+				Status: entities.TransactionPosted,
+				Entries: []entities.Entry{
+					{ID: 1, TransactionID: 7, Account: "jc", Direction: "credit", Amount: 100, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: entities.TransactionPosted, CreatedAt: &timeMock},
+					{ID: 2, TransactionID: 7, Account: "external", Direction: "debit", Amount: 100, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: entities.TransactionPosted, CreatedAt: &timeMock},
+				},
+				CreatedAt: &timeMock,
+			},
+			transactionCalls: 1,
+		},
+		{
+			name:             "Invalid ID",
+			id:               "abc",
+			expectedCode:     http.StatusBadRequest,
+			transactionCalls: 0,
+		},
+		{
+			name:             "Not found",
+			id:               "8",
+			expectedCode:     http.StatusNotFound,
+			expectedBody:     `{"type":"about:blank","title":"Not Found","status":404,"detail":"transaction not found","instance":"/transactions/8","code":"transaction_not_found"}`,
+			useCaseError:     domain.ErrTransactionNotFound,
+			transactionCalls: 1,
+		},
+		{
+			name:             "Error from UseCase",
+			id:               "9",
+			expectedCode:     http.StatusInternalServerError,
+			expectedBody:     `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/transactions/9","code":"internal_error"}`,
+			useCaseError:     assert.AnError,
+			transactionCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.TransactionUseCaseMock{
+				TransactionFunc: func(ctx context.Context, id int64) (*entities.Transaction, error) {
+					return tt.useCaseReturn, tt.useCaseError
+				},
+			}
+
+			handler := v1.GetTransactionHandler(mockUseCase)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest("GET", "/transactions/"+tt.id, nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.TransactionCalls()
+			assert.Len(t, calls, tt.transactionCalls)
+		})
+	}
+}
+
+func TestSettleTransactionHandlers(t *testing.T) {
+	settled := func(id int64, status entities.TransactionStatus) *entities.Transaction {
+		return &entities.Transaction{
+			ID:     id,
+			Status: status,
+			Entries: []entities.Entry{
+				{ID: 1, TransactionID: id, Account: "jc", Direction: "credit", Amount: 100, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: status},
+				{ID: 2, TransactionID: id, Account: "merchant", Direction: "debit", Amount: 100, Currency: entities.Currency{Code: "USD", Exponent: 2}, Status: status},
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		action       string
+		id           string
+		expectedCode int
+		expectedBody string
+		mockError    error
+		postCalls    int
+		voidCalls    int
+	}{
+		{
+			name:         "Post",
+			action:       "post",
+			id:           "7",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"ID":7,"Status":"posted","ReversalOf":0,"Entries":[` +
+				`{"ID":1,"TransactionID":7,"Account":"jc","Direction":"credit","Amount":100,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":null},` +
+				`{"ID":2,"TransactionID":7,"Account":"merchant","Direction":"debit","Amount":100,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":null}` +
+				`],"CreatedAt":null}`,
+			postCalls: 1,
+		},
+		{
+			name:         "Void",
+			action:       "void",
+			id:           "7",
+			expectedCode: http.StatusOK,
+			voidCalls:    1,
+		},
+		{
+			name:         "Invalid ID",
+			action:       "post",
+			id:           "abc",
+			expectedCode: http.StatusBadRequest,
+			postCalls:    0,
+		},
+		{
+			name:         "Already voided",
+			action:       "post",
+			id:           "8",
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"type":"about:blank","title":"Conflict","status":409,"detail":"transaction is not pending","instance":"/transactions/8/post","code":"transaction_not_pending"}`,
+			mockError:    domain.ErrTransactionNotPending,
+			postCalls:    1,
+		},
+		{
+			name:         "Not found",
+			action:       "void",
+			id:           "9",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"transaction not found","instance":"/transactions/9/void","code":"transaction_not_found"}`,
+			mockError:    domain.ErrTransactionNotFound,
+			voidCalls:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postUseCase := &mocks.PostTransactionUseCaseMock{
+				PostTransactionFunc: func(ctx context.Context, id int64) (*entities.Transaction, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return settled(id, entities.TransactionPosted), nil
+				},
+			}
+			voidUseCase := &mocks.VoidTransactionUseCaseMock{
+				VoidTransactionFunc: func(ctx context.Context, id int64) (*entities.Transaction, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return settled(id, entities.TransactionVoided), nil
+				},
+			}
+
+			handler := v1.PostTransactionHandler(postUseCase)
+			if tt.action == "void" {
+				handler = v1.VoidTransactionHandler(voidUseCase)
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest(http.MethodPost, "/transactions/"+tt.id+"/"+tt.action, nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			assert.Len(t, postUseCase.PostTransactionCalls(), tt.postCalls)
+			assert.Len(t, voidUseCase.VoidTransactionCalls(), tt.voidCalls)
+		})
+	}
+}
+
+func TestReverseTransactionHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.ReverseTransactionInput
+		mockError     error
+		reverseCalls  int
+	}{
+		{
+			name:          "Full reversal without a body",
+			id:            "7",
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `{"transaction_id":8}`,
+			expectedInput: account.ReverseTransactionInput{TransactionID: 7},
+			reverseCalls:  1,
+		},
+		{
+			name:          "Partial reversal",
+			id:            "7",
+			requestBody:   `{"amount": 40}`,
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `{"transaction_id":8}`,
+			expectedInput: account.ReverseTransactionInput{TransactionID: 7, Amount: 40},
+			reverseCalls:  1,
+		},
+		{
+			name:         "Invalid payload",
+			id:           "7",
+			requestBody:  `{"amount": "40"}`,
+			expectedCode: http.StatusBadRequest,
+			reverseCalls: 0,
+		},
+		{
+			name:          "Already reversed",
+			id:            "7",
+			expectedCode:  http.StatusConflict,
+			expectedBody:  `{"type":"about:blank","title":"Conflict","status":409,"detail":"transaction already reversed","instance":"/transactions/7/reverse","code":"transaction_already_reversed"}`,
+			expectedInput: account.ReverseTransactionInput{TransactionID: 7},
+			mockError:     domain.ErrTransactionAlreadyReversed,
+			reverseCalls:  1,
+		},
+		{
+			name:          "Exceeds transaction",
+			id:            "7",
+			requestBody:   `{"amount": 400}`,
+			expectedCode:  http.StatusUnprocessableEntity,
+			expectedBody:  `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"reversal exceeds the unreversed amount","instance":"/transactions/7/reverse","code":"reversal_exceeds_transaction"}`,
+			expectedInput: account.ReverseTransactionInput{TransactionID: 7, Amount: 400},
+			mockError:     domain.ErrReversalExceedsTransaction,
+			reverseCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.ReverseTransactionUseCaseMock{
+				ReverseTransactionFunc: func(ctx context.Context, input account.ReverseTransactionInput) (int64, error) {
+					if tt.mockError != nil {
+						return 0, tt.mockError
+					}
+					return 8, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest(http.MethodPost, "/transactions/"+tt.id+"/reverse", strings.NewReader(tt.requestBody))
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.ReverseTransactionHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.ReverseTransactionCalls()
+			assert.Len(t, calls, tt.reverseCalls)
+			if tt.reverseCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
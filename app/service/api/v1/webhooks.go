@@ -0,0 +1,270 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// WebhookResponse is a subscription to the transactions posted on an account.
+type WebhookResponse struct {
+	ID      int64  `json:"id"`
+	Account string `json:"account"`
+	URL     string `json:"url"`
+	// Key signing the deliveries, only returned when the webhook is created
+	Secret    string                 `json:"secret,omitempty"`
+	Status    entities.WebhookStatus `json:"status" enums:"active,disabled"`
+	CreatedAt *time.Time             `json:"created_at"`
+}
+
+func newWebhookResponse(w *entities.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:        w.ID,
+		Account:   w.Account,
+		URL:       w.URL,
+		Status:    w.Status,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+type CreateWebhookRequest struct {
+	Account string `json:"account"`
+	// Absolute http or https URL receiving the deliveries
+	URL string `json:"url"`
+	// Key signing the deliveries, generated when omitted
+	Secret string `json:"secret,omitempty"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/create_webhook_uc.go . CreateWebhookUseCase
+type CreateWebhookUseCase interface {
+	CreateWebhook(ctx context.Context, input account.CreateWebhookInput) (*entities.Webhook, error)
+}
+
+// CreateWebhookHandler godoc
+// @Summary      Create a webhook
+// @Description  Subscribe a URL to the transactions posted on an account. Every delivery is a POST of a JSON document signed with the secret: the Ledger-Signature header is "sha256=" followed by the hex HMAC-SHA256 of the Ledger-Timestamp header, a dot and the body. Failed deliveries are retried with an exponential backoff, 8 attempts at most.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param request body CreateWebhookRequest true "Webhook details"
+// @Success      201 {object} WebhookResponse "Webhook created successfully"
+// @Failure      400 {object} Problem "Invalid request payload"
+// @Failure      404 {object} Problem "Account not found"
+// @Failure      422 {object} Problem "Invalid account or URL"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/webhooks [post]
+func CreateWebhookHandler(uc CreateWebhookUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateWebhookRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		input := account.CreateWebhookInput{
+			Account: req.Account,
+			URL:     req.URL,
+			Secret:  req.Secret,
+		}
+		webhook, err := uc.CreateWebhook(r.Context(), input)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := newWebhookResponse(webhook)
+		resp.Secret = webhook.Secret
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+type WebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/list_webhooks_uc.go . ListWebhooksUseCase
+type ListWebhooksUseCase interface {
+	ListWebhooks(ctx context.Context, account string) ([]entities.Webhook, error)
+}
+
+// ListWebhooksHandler godoc
+// @Summary      List webhooks
+// @Description  Get the webhook subscriptions, ordered by ID, disabled ones included
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        account query string false "Only the webhooks of this account"
+// @Success      200 {object} WebhooksResponse "Webhooks retrieved successfully"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/webhooks [get]
+func ListWebhooksHandler(uc ListWebhooksUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhooks, err := uc.ListWebhooks(r.Context(), r.URL.Query().Get("account"))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := WebhooksResponse{Webhooks: make([]WebhookResponse, 0, len(webhooks))}
+		for i := range webhooks {
+			resp.Webhooks = append(resp.Webhooks, newWebhookResponse(&webhooks[i]))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/delete_webhook_uc.go . DeleteWebhookUseCase
+type DeleteWebhookUseCase interface {
+	DeleteWebhook(ctx context.Context, id int64) (*entities.Webhook, error)
+}
+
+// DeleteWebhookHandler godoc
+// @Summary      Delete a webhook
+// @Description  Disable a webhook subscription, including the retries of its failed deliveries. Its delivery log is kept.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Webhook ID"
+// @Success      200 {object} WebhookResponse "Webhook disabled successfully"
+// @Failure      400 {object} Problem "Invalid webhook ID"
+// @Failure      404 {object} Problem "Webhook not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/webhooks/{id} [delete]
+func DeleteWebhookHandler(uc DeleteWebhookUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid webhook ID")
+			return
+		}
+
+		webhook, err := uc.DeleteWebhook(r.Context(), id)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newWebhookResponse(webhook))
+	}
+}
+
+// WebhookDeliveryResponse is the notification of an event to a webhook and
+// the outcome of its last attempt.
+type WebhookDeliveryResponse struct {
+	ID            int64  `json:"id"`
+	WebhookID     int64  `json:"webhook_id"`
+	EventID       int64  `json:"event_id"`
+	EventType     string `json:"event_type"`
+	TransactionID int64  `json:"transaction_id"`
+	Account       string `json:"account"`
+	// Dead deliveries failed every attempt and are no longer retried
+	Status   entities.WebhookDeliveryStatus `json:"status" enums:"pending,succeeded,dead"`
+	Attempts int                            `json:"attempts"`
+	// When the delivery is attempted next, omitted unless pending
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// Response status code of the last attempt, omitted if there was no response
+	LastStatusCode int `json:"last_status_code,omitempty"`
+	// Error of the last attempt, omitted unless it failed
+	LastError   string     `json:"last_error,omitempty"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+func newWebhookDeliveryResponse(d *entities.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		TransactionID:  d.TransactionID,
+		Account:        d.Account,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.Status == entities.WebhookDeliveryPending {
+		resp.NextAttemptAt = &d.NextAttemptAt
+	}
+
+	return resp
+}
+
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+}
+
+//go:generate moq -stub -pkg mocks -out mocks/webhook_deliveries_uc.go . WebhookDeliveriesUseCase
+type WebhookDeliveriesUseCase interface {
+	WebhookDeliveries(ctx context.Context, query entities.WebhookDeliveryQuery) ([]entities.WebhookDelivery, error)
+}
+
+// GetWebhookDeliveriesHandler godoc
+// @Summary      Get the delivery log of a webhook
+// @Description  Get a page of the deliveries of a webhook, newest first, with the outcome of their last attempt
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Webhook ID"
+// @Param        limit query int false "Page size, up to 500" default(50)
+// @Param        before query int false "Only deliveries older than this delivery ID"
+// @Success      200 {object} WebhookDeliveriesResponse "Deliveries retrieved successfully"
+// @Failure      400 {object} Problem "Invalid webhook ID or query parameters"
+// @Failure      404 {object} Problem "Webhook not found"
+// @Failure      500 {object} Problem "Internal Server Error"
+// @Router       /api/v1/webhooks/{id}/deliveries [get]
+func GetWebhookDeliveriesHandler(uc WebhookDeliveriesUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid webhook ID")
+			return
+		}
+
+		query := entities.WebhookDeliveryQuery{WebhookID: id}
+		q := r.URL.Query()
+		if v := q.Get("limit"); v != "" {
+			if query.Limit, err = strconv.Atoi(v); err != nil {
+				writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid limit: "+err.Error())
+				return
+			}
+		}
+		if v := q.Get("before"); v != "" {
+			if query.Before, err = strconv.ParseInt(v, 10, 64); err != nil {
+				writeProblem(w, r, http.StatusBadRequest, CodeInvalidRequest, "invalid before: "+err.Error())
+				return
+			}
+		}
+
+		deliveries, err := uc.WebhookDeliveries(r.Context(), query)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := WebhookDeliveriesResponse{Deliveries: make([]WebhookDeliveryResponse, 0, len(deliveries))}
+		for i := range deliveries {
+			resp.Deliveries = append(resp.Deliveries, newWebhookDeliveryResponse(&deliveries[i]))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
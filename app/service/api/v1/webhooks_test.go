@@ -0,0 +1,304 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/julioc98/ledger/app/service/api/v1"
+	"github.com/julioc98/ledger/app/service/api/v1/mocks"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/account"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedBody  string
+		expectedInput account.CreateWebhookInput
+		mockError     error
+		createCalls   int
+	}{
+		{
+			name:          "Valid request",
+			requestBody:   `{"account": "jc", "url": "https://partner.example/hooks", "secret": "whsec"}`,
+			expectedCode:  http.StatusCreated,
+			expectedBody:  `{"id":1,"account":"jc","url":"https://partner.example/hooks","secret":"whsec","status":"active","created_at":null}`,
+			expectedInput: account.CreateWebhookInput{Account: "jc", URL: "https://partner.example/hooks", Secret: "whsec"},
+			createCalls:   1,
+		},
+		{
+			name:         "Invalid payload",
+			requestBody:  `{"account": 1}`,
+			expectedCode: http.StatusBadRequest,
+			createCalls:  0,
+		},
+		{
+			name:          "Invalid URL",
+			requestBody:   `{"account": "jc", "url": "ftp://partner.example"}`,
+			expectedCode:  http.StatusUnprocessableEntity,
+			expectedBody:  `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"webhook URL must be an absolute http or https URL","instance":"/webhooks","code":"invalid_webhook_url"}`,
+			expectedInput: account.CreateWebhookInput{Account: "jc", URL: "ftp://partner.example"},
+			mockError:     domain.ErrInvalidWebhookURL,
+			createCalls:   1,
+		},
+		{
+			name:          "Account not found",
+			requestBody:   `{"account": "jc", "url": "https://partner.example/hooks"}`,
+			expectedCode:  http.StatusNotFound,
+			expectedBody:  `{"type":"about:blank","title":"Not Found","status":404,"detail":"account not found","instance":"/webhooks","code":"account_not_found"}`,
+			expectedInput: account.CreateWebhookInput{Account: "jc", URL: "https://partner.example/hooks"},
+			mockError:     domain.ErrAccountNotFound,
+			createCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.CreateWebhookUseCaseMock{
+				CreateWebhookFunc: func(ctx context.Context, input account.CreateWebhookInput) (*entities.Webhook, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Webhook{ID: 1, Account: input.Account, URL: input.URL, Secret: input.Secret, Status: entities.WebhookActive}, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.requestBody))
+
+			w := httptest.NewRecorder()
+			v1.CreateWebhookHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.CreateWebhookCalls()
+			assert.Len(t, calls, tt.createCalls)
+			if tt.createCalls > 0 {
+				assert.Equal(t, tt.expectedInput, calls[0].Input)
+			}
+		})
+	}
+}
+
+func TestListWebhooksHandler(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		expectedCode    int
+		expectedBody    string
+		expectedAccount string
+		mockWebhooks    []entities.Webhook
+		mockError       error
+	}{
+		{
+			name:            "Filtered by account",
+			url:             "/webhooks?account=jc",
+			expectedCode:    http.StatusOK,
+			expectedBody:    `{"webhooks":[{"id":1,"account":"jc","url":"https://partner.example/hooks","status":"active","created_at":null}]}`,
+			expectedAccount: "jc",
+			mockWebhooks:    []entities.Webhook{{ID: 1, Account: "jc", URL: "https://partner.example/hooks", Secret: "whsec", Status: entities.WebhookActive}},
+		},
+		{
+			name:         "No webhooks",
+			url:          "/webhooks",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"webhooks":[]}`,
+			mockWebhooks: []entities.Webhook{},
+		},
+		{
+			name:         "Internal error",
+			url:          "/webhooks",
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/webhooks","code":"internal_error"}`,
+			mockError:    assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.ListWebhooksUseCaseMock{
+				ListWebhooksFunc: func(ctx context.Context, account string) ([]entities.Webhook, error) {
+					return tt.mockWebhooks, tt.mockError
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+
+			w := httptest.NewRecorder()
+			v1.ListWebhooksHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+
+			calls := mockUseCase.ListWebhooksCalls()
+			if assert.Len(t, calls, 1) {
+				assert.Equal(t, tt.expectedAccount, calls[0].Account)
+			}
+		})
+	}
+}
+
+func TestDeleteWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		expectedCode int
+		expectedBody string
+		mockError    error
+		deleteCalls  int
+	}{
+		{
+			name:         "Valid request",
+			id:           "1",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"id":1,"account":"jc","url":"https://partner.example/hooks","status":"disabled","created_at":null}`,
+			deleteCalls:  1,
+		},
+		{
+			name:         "Invalid ID",
+			id:           "one",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid webhook ID","instance":"/webhooks/one","code":"invalid_request"}`,
+			deleteCalls:  0,
+		},
+		{
+			name:         "Webhook not found",
+			id:           "1",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"type":"about:blank","title":"Not Found","status":404,"detail":"webhook not found","instance":"/webhooks/1","code":"webhook_not_found"}`,
+			mockError:    domain.ErrWebhookNotFound,
+			deleteCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.DeleteWebhookUseCaseMock{
+				DeleteWebhookFunc: func(ctx context.Context, id int64) (*entities.Webhook, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return &entities.Webhook{ID: id, Account: "jc", URL: "https://partner.example/hooks", Secret: "whsec", Status: entities.WebhookDisabled}, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest(http.MethodDelete, "/webhooks/"+tt.id, nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.DeleteWebhookHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			assert.Len(t, mockUseCase.DeleteWebhookCalls(), tt.deleteCalls)
+		})
+	}
+}
+
+func TestGetWebhookDeliveriesHandler(t *testing.T) {
+	nextAttemptAt := time.Date(2026, 10, 18, 12, 1, 0, 0, time.UTC)
+	deliveredAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	deliveries := []entities.WebhookDelivery{
+		{
+			ID: 2, WebhookID: 1, EventID: 4, EventType: entities.EventTransactionPosted, TransactionID: 9, Account: "jc",
+			Status: entities.WebhookDeliveryPending, Attempts: 1, NextAttemptAt: nextAttemptAt, LastStatusCode: 503, LastError: "unexpected response status: 503 Service Unavailable",
+		},
+		{
+			ID: 1, WebhookID: 1, EventID: 3, EventType: entities.EventTransactionPosted, TransactionID: 8, Account: "jc",
+			Status: entities.WebhookDeliverySucceeded, Attempts: 2, NextAttemptAt: nextAttemptAt, LastStatusCode: 200, DeliveredAt: &deliveredAt,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		id            string
+		query         string
+		expectedCode  int
+		expectedBody  string
+		expectedQuery entities.WebhookDeliveryQuery
+		mockError     error
+		deliveryCalls int
+	}{
+		{
+			name:         "Valid request",
+			id:           "1",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"deliveries":[` +
+				`{"id":2,"webhook_id":1,"event_id":4,"event_type":"TransactionPosted","transaction_id":9,"account":"jc","status":"pending","attempts":1,"next_attempt_at":"2026-10-18T12:01:00Z","last_status_code":503,"last_error":"unexpected response status: 503 Service Unavailable","created_at":null},` +
+				`{"id":1,"webhook_id":1,"event_id":3,"event_type":"TransactionPosted","transaction_id":8,"account":"jc","status":"succeeded","attempts":2,"last_status_code":200,"delivered_at":"2026-10-18T12:00:00Z","created_at":null}]}`,
+			expectedQuery: entities.WebhookDeliveryQuery{WebhookID: 1},
+			deliveryCalls: 1,
+		},
+		{
+			name:          "Paged request",
+			id:            "1",
+			query:         "?limit=10&before=2",
+			expectedCode:  http.StatusOK,
+			expectedQuery: entities.WebhookDeliveryQuery{WebhookID: 1, Before: 2, Limit: 10},
+			deliveryCalls: 1,
+		},
+		{
+			name:          "Invalid before",
+			id:            "1",
+			query:         "?before=last",
+			expectedCode:  http.StatusBadRequest,
+			deliveryCalls: 0,
+		},
+		{
+			name:          "Webhook not found",
+			id:            "5",
+			expectedCode:  http.StatusNotFound,
+			expectedBody:  `{"type":"about:blank","title":"Not Found","status":404,"detail":"webhook not found","instance":"/webhooks/5/deliveries","code":"webhook_not_found"}`,
+			expectedQuery: entities.WebhookDeliveryQuery{WebhookID: 5},
+			mockError:     domain.ErrWebhookNotFound,
+			deliveryCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.WebhookDeliveriesUseCaseMock{
+				WebhookDeliveriesFunc: func(ctx context.Context, query entities.WebhookDeliveryQuery) ([]entities.WebhookDelivery, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return deliveries, nil
+				},
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req := httptest.NewRequest(http.MethodGet, "/webhooks/"+tt.id+"/deliveries"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			v1.GetWebhookDeliveriesHandler(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, strings.TrimSpace(w.Body.String()))
+			}
+
+			calls := mockUseCase.WebhookDeliveriesCalls()
+			assert.Len(t, calls, tt.deliveryCalls)
+			if tt.deliveryCalls > 0 {
+				assert.Equal(t, tt.expectedQuery, calls[0].Query)
+			}
+		})
+	}
+}
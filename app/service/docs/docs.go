@@ -26,7 +26,7 @@ const docTemplate = `{
     "paths": {
         "/api/v1/account/{account}/balance": {
             "get": {
-                "description": "Get the balance of the specified account",
+                "description": "Get the balance of the specified account, now or at a point in time, optionally rolling up its descendants",
                 "consumes": [
                     "application/json"
                 ],
@@ -44,14 +44,45 @@ const docTemplate = `{
                         "name": "account",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time to compute the balance at, defaults to now",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": "false",
+                        "description": "Add up the balances of the account's whole subtree",
+                        "name": "include_children",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balance retrieved successfully"
+                        "description": "Balance retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid as_of time or include_children flag",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     }
                 }
             }
@@ -77,6 +108,12 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retrying the request safe",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
                     {
                         "description": "Deposit details",
                         "name": "request",
@@ -89,20 +126,248 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "201": {
-                        "description": "Deposit created successfully"
+                        "description": "Deposit created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key already used with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/account/{account}/events": {
+            "get": {
+                "description": "Server-Sent Events stream of the entries of an account, sent as they are committed. Each entry is an \"entry\" event whose id is the entry ID and whose data is the entry as in the transfer history. Reconnecting with the Last-Event-ID header resumes the stream after that entry; without it, the stream starts with the entries committed from then on.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Stream account activity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last entry received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of entry events",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Entry"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Last-Event-ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/account/{account}/holds": {
+            "post": {
+                "description": "Reserve funds of an account until the hold is captured, released or expires. Held funds are not available to transfers, withdrawals or other holds.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holds"
+                ],
+                "summary": "Create a hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hold details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateHoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Hold created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "402": {
+                        "description": "Insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Account frozen or closed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or expiry",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/account/{account}/pending-transfers": {
+            "post": {
+                "description": "Reserve the funds of a transfer from the specified account in a pending transaction, to be posted or voided later",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Create a pending transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retrying the request safe",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Transfer details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Pending transfer created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionCreatedResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid request payload"
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "402": {
+                        "description": "Insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key already used with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     }
                 }
             }
         },
         "/api/v1/account/{account}/transfers": {
             "get": {
-                "description": "Get the transfer history of the specified account",
+                "description": "Get a page of the transfer history of the specified account, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -120,14 +385,73 @@ const docTemplate = `{
                         "name": "account",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size, up to 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries created at or after this RFC 3339 time",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries created before this RFC 3339 time",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "debit",
+                            "credit"
+                        ],
+                        "type": "string",
+                        "description": "Only debits or only credits",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only entries of at least this amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only entries of at most this amount",
+                        "name": "max_amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Transfers history retrieved successfully"
+                        "description": "Transfers history retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/account.TransfersHistoryOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     }
                 }
             },
@@ -151,6 +475,12 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retrying the request safe",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
                     {
                         "description": "Transfer details",
                         "name": "request",
@@ -163,38 +493,2143 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "201": {
-                        "description": "Transfer created successfully"
+                        "description": "Transfer created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionCreatedResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid request payload"
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     },
                     "402": {
-                        "description": "Insufficient funds"
+                        "description": "Insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key already used with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "v1.CreateDepositRequest": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "integer"
-                }
-            }
         },
-        "v1.CreateTransferRequest": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "integer"
-                },
-                "to": {
-                    "type": "string"
+        "/api/v1/account/{account}/withdrawals": {
+            "post": {
+                "description": "Withdraw funds from the specified account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Create a withdrawal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retrying the request safe",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Withdrawal details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateWithdrawalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Withdrawal created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "402": {
+                        "description": "Insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key already used with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts": {
+            "get": {
+                "description": "Get a page of the registered accounts, ordered by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "List accounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only accounts of this owner",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the children of this account",
+                        "name": "parent",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asset",
+                            "liability",
+                            "equity",
+                            "revenue",
+                            "expense"
+                        ],
+                        "type": "string",
+                        "description": "Only accounts of this type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "frozen",
+                            "closed"
+                        ],
+                        "type": "string",
+                        "description": "Only accounts in this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size, up to 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Accounts retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a new open account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Create an account",
+                "parameters": [
+                    {
+                        "description": "Account details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Account created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid account type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Account already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid account ID or parent",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{account}": {
+            "get": {
+                "description": "Get a registered account with its status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Get an account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Account retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Close an account for good. Accounts are never removed, so their history stays available.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Close an account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Account closed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Change the owner or the status of an account, e.g. to freeze or unfreeze it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Update an account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Account updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{account}/balance": {
+            "get": {
+                "description": "Get the balance of the specified account, now or at a point in time, optionally rolling up its descendants",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Get account balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time to compute the balance at, defaults to now",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": "false",
+                        "description": "Add up the balances of the account's whole subtree",
+                        "name": "include_children",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balance retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid as_of time or include_children flag",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{account}/overdraft-limit": {
+            "put": {
+                "description": "Change how far below zero postings may take the balance of an account in each currency. The change is recorded in the account's audit trail.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Set the overdraft limit of an account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New limit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.OverdraftLimitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Overdraft limit changed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid overdraft limit",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{account}/overdraft-limit/changes": {
+            "get": {
+                "description": "Get every change of the overdraft limit of an account, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Get the overdraft limit audit trail of an account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changes retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.OverdraftLimitChangesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/audit/hash-chain": {
+            "get": {
+                "description": "Walk the entries' hash chain, recomputing every entry's hash from its content and the previous entry's hash, and report the first broken link",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "Verify the hash chain",
+                "responses": {
+                    "200": {
+                        "description": "Hash chain verified",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HashChainResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/holds/{id}/capture": {
+            "post": {
+                "description": "Settle an active hold by transferring up to the held amount to another account. The rest of the hold is released.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holds"
+                ],
+                "summary": "Capture a hold",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Capture details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CaptureHoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hold captured successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Hold or account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Hold not active or expired",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Amount exceeds the hold",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/holds/{id}/release": {
+            "post": {
+                "description": "Give up an active hold, making its funds available again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holds"
+                ],
+                "summary": "Release a hold",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hold released successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid hold ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Hold not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Hold not active or expired",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reports/trial-balance": {
+            "get": {
+                "description": "List the posted debit and credit totals of every account, now or at a point in time, with the totals of each currency, whose debits must equal their credits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get the trial balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time to compute the trial balance at, defaults to now",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trial balance retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrialBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid as_of time",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reports/unbalanced-transactions": {
+            "get": {
+                "description": "List the transactions, whatever their status, whose legs do not net to zero in some currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Check the double-entry invariant",
+                "responses": {
+                    "200": {
+                        "description": "Invariant checked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UnbalancedTransactionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions": {
+            "post": {
+                "description": "Create a transaction with any number of legs whose debits sum to its credits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create a transaction",
+                "parameters": [
+                    {
+                        "description": "Transaction legs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Transaction created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "402": {
+                        "description": "Insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}": {
+            "get": {
+                "description": "Get a transaction with all of its entries",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get a transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/post": {
+            "post": {
+                "description": "Make a pending transaction final, moving the funds it reserved",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Post a pending transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction posted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaction not pending or account frozen or closed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/reverse": {
+            "post": {
+                "description": "Book a transaction with the opposite entries of a posted one, linked to it through ReversalOf. The original transaction is left untouched. The request body is optional; without an amount all that is left of the transaction is reversed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Reverse a posted transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reversal details",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReverseTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Reversal created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "402": {
+                        "description": "Insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaction not posted or already reversed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid amount or amount exceeds the transaction",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/void": {
+            "post": {
+                "description": "Cancel a pending transaction, making the funds it reserved available again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Void a pending transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction voided successfully",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaction not pending",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks": {
+            "get": {
+                "description": "Get the webhook subscriptions, ordered by ID, disabled ones included",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only the webhooks of this account",
+                        "name": "account",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhooks retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhooksResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribe a URL to the transactions posted on an account. Every delivery is a POST of a JSON document signed with the secret: the Ledger-Signature header is \"sha256=\" followed by the hex HMAC-SHA256 of the Ledger-Timestamp header, a dot and the body. Failed deliveries are retried with an exponential backoff, 8 attempts at most.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid account or URL",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}": {
+            "delete": {
+                "description": "Disable a webhook subscription, including the retries of its failed deliveries. Its delivery log is kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook disabled successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Get a page of the deliveries of a webhook, newest first, with the outcome of their last attempt",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get the delivery log of a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size, up to 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only deliveries older than this delivery ID",
+                        "name": "before",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID or query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "account.TransfersHistoryOutput": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Entry"
+                    }
+                },
+                "next_cursor": {
+                    "description": "NextCursor fetches the next page, empty on the last one.",
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AccountStatus": {
+            "description": "AccountStatus is the lifecycle state of an account.",
+            "type": "string",
+            "enum": [
+                "open",
+                "frozen",
+                "closed"
+            ],
+            "x-enum-varnames": [
+                "AccountOpen",
+                "AccountFrozen",
+                "AccountClosed"
+            ]
+        },
+        "entities.AccountType": {
+            "description": "AccountType is the class of an account in the chart of accounts. It\ndetermines the account's normal balance side.",
+            "type": "string",
+            "enum": [
+                "asset",
+                "liability",
+                "equity",
+                "revenue",
+                "expense"
+            ],
+            "x-enum-varnames": [
+                "Asset",
+                "Liability",
+                "Equity",
+                "Revenue",
+                "Expense"
+            ]
+        },
+        "entities.Currency": {
+            "description": "Currency is an ISO 4217 currency.",
+            "type": "object",
+            "properties": {
+                "Code": {
+                    "type": "string"
+                },
+                "Exponent": {
+                    "description": "Exponent is the number of digits of the minor unit, e.g. 2 for cents.\nAmounts are always expressed in minor units.",
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.Direction": {
+            "description": "Direction is the direction of an entry.",
+            "type": "string",
+            "enum": [
+                "debit",
+                "credit"
+            ],
+            "x-enum-varnames": [
+                "Debit",
+                "Credit"
+            ]
+        },
+        "entities.Entry": {
+            "description": "Entry is a representation of a single entry in the ledger.",
+            "type": "object",
+            "properties": {
+                "Account": {
+                    "type": "string"
+                },
+                "Amount": {
+                    "type": "integer"
+                },
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "Currency": {
+                    "$ref": "#/definitions/entities.Currency"
+                },
+                "Direction": {
+                    "$ref": "#/definitions/entities.Direction"
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "ReversalOf": {
+                    "type": "integer"
+                },
+                "Status": {
+                    "description": "Status is the status of the transaction the entry belongs to, and\nReversalOf the transaction it reverses, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TransactionStatus"
+                        }
+                    ]
+                },
+                "TransactionID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.HoldStatus": {
+            "description": "HoldStatus is the lifecycle state of a hold.",
+            "type": "string",
+            "enum": [
+                "active",
+                "captured",
+                "released",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "HoldActive",
+                "HoldCaptured",
+                "HoldReleased",
+                "HoldExpired"
+            ]
+        },
+        "entities.Transaction": {
+            "description": "Transaction groups the entries booked together in the ledger.",
+            "type": "object",
+            "properties": {
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "Entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Entry"
+                    }
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "ReversalOf": {
+                    "description": "ReversalOf is the ID of the transaction this one reverses, zero for\ntransactions that are not reversals.",
+                    "type": "integer"
+                },
+                "Status": {
+                    "$ref": "#/definitions/entities.TransactionStatus"
+                }
+            }
+        },
+        "entities.TransactionStatus": {
+            "description": "TransactionStatus is the lifecycle state of a transaction.",
+            "type": "string",
+            "enum": [
+                "pending",
+                "posted",
+                "voided"
+            ],
+            "x-enum-varnames": [
+                "TransactionPending",
+                "TransactionPosted",
+                "TransactionVoided"
+            ]
+        },
+        "entities.WebhookDeliveryStatus": {
+            "description": "WebhookDeliveryStatus is the lifecycle state of a webhook delivery.",
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "dead"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryDead"
+            ]
+        },
+        "entities.WebhookStatus": {
+            "description": "WebhookStatus is the lifecycle state of a webhook subscription.",
+            "type": "string",
+            "enum": [
+                "active",
+                "disabled"
+            ],
+            "x-enum-varnames": [
+                "WebhookActive",
+                "WebhookDisabled"
+            ]
+        },
+        "v1.AccountResponse": {
+            "description": "AccountResponse is a registered ledger account.",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "overdraft_limit": {
+                    "description": "How far below zero postings may take the balance, in minor units;\n-1 means unlimited",
+                    "type": "integer"
+                },
+                "owner": {
+                    "description": "Reference to the customer or system owning the account",
+                    "type": "string"
+                },
+                "parent": {
+                    "description": "Account this one rolls up into, omitted for root accounts",
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.AccountStatus"
+                },
+                "type": {
+                    "description": "Determines the normal balance side: debit for asset and expense\naccounts, credit for the others",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.AccountType"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.AccountsResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AccountResponse"
+                    }
+                },
+                "next_cursor": {
+                    "description": "Fetches the next page, empty on the last one",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BalanceResponse": {
+            "type": "object",
+            "properties": {
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CurrencyBalance"
+                    }
+                }
+            }
+        },
+        "v1.CaptureHoldRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount to transfer, up to the held amount; the whole hold when omitted",
+                    "type": "integer"
+                },
+                "to": {
+                    "description": "Account receiving the funds",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "Reference to the customer or system owning the account",
+                    "type": "string"
+                },
+                "parent": {
+                    "description": "Account this one rolls up into, which must have the same type",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Account type, asset when omitted",
+                    "type": "string",
+                    "enum": [
+                        "asset",
+                        "liability",
+                        "equity",
+                        "revenue",
+                        "expense"
+                    ]
+                }
+            }
+        },
+        "v1.CreateDepositRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code, defaults to USD",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateHoldRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code, defaults to USD",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "When the hold lapses, in a week when omitted",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateTransactionLeg": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "integer"
+                },
+                "direction": {
+                    "$ref": "#/definitions/entities.Direction"
+                }
+            }
+        },
+        "v1.CreateTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "ISO 4217 currency code of every leg, defaults to USD",
+                    "type": "string"
+                },
+                "legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CreateTransactionLeg"
+                    }
+                }
+            }
+        },
+        "v1.CreateTransferRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code, defaults to USD",
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Key signing the deliveries, generated when omitted",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Absolute http or https URL receiving the deliveries",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateWithdrawalRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code, defaults to USD",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CurrencyBalance": {
+            "description": "CurrencyBalance is the balance of an account in a single currency.",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Posted balance in minor units",
+                    "type": "integer"
+                },
+                "available": {
+                    "description": "Posted balance minus the funds reserved by active holds and pending\ntransfers",
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code",
+                    "type": "string"
+                },
+                "exponent": {
+                    "description": "Number of digits of the minor unit",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.HashChainResponse": {
+            "description": "HashChainResponse is the outcome of verifying the entries' hash chain.",
+            "type": "object",
+            "properties": {
+                "broken_entry_id": {
+                    "description": "First entry whose hash does not match, omitted when valid",
+                    "type": "integer"
+                },
+                "expected_hash": {
+                    "description": "Hash computed for the broken entry",
+                    "type": "string"
+                },
+                "stored_hash": {
+                    "description": "Hash stored for the broken entry, empty if it has none",
+                    "type": "string"
+                },
+                "valid": {
+                    "description": "Whether every entry's hash matched",
+                    "type": "boolean"
+                },
+                "verified": {
+                    "description": "Number of entries verified before the first broken link",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.HoldResponse": {
+            "description": "HoldResponse is a reservation of an account's funds.",
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "amount": {
+                    "description": "Held amount in minor units",
+                    "type": "integer"
+                },
+                "captured": {
+                    "description": "Amount transferred by the capture, omitted unless captured",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.HoldStatus"
+                },
+                "transaction_id": {
+                    "description": "Transaction booking the capture, omitted unless captured",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.OverdraftLimitChange": {
+            "description": "OverdraftLimitChange is an entry of the audit trail of an account's\noverdraft limit.",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_limit": {
+                    "type": "integer"
+                },
+                "old_limit": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OverdraftLimitChangesResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.OverdraftLimitChange"
+                    }
+                }
+            }
+        },
+        "v1.OverdraftLimitRequest": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "How far below zero postings may take the balance, in minor units;\n-1 means unlimited",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "Kept in the audit trail of the change",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.Problem": {
+            "description": "Problem is an RFC 7807 problem details error response.",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Stable machine-readable error code",
+                    "type": "string",
+                    "enum": [
+                        "invalid_request",
+                        "internal_error",
+                        "insufficient_funds",
+                        "invalid_direction",
+                        "amounts_not_match",
+                        "currencies_not_match",
+                        "invalid_currency",
+                        "unbalanced_journal_entry",
+                        "journal_entry_legs",
+                        "idempotency_key_reused",
+                        "transaction_not_found",
+                        "transaction_not_pending",
+                        "transaction_not_posted",
+                        "transaction_already_reversed",
+                        "reversal_exceeds_transaction",
+                        "partial_reversal_legs",
+                        "invalid_amount",
+                        "empty_account",
+                        "invalid_account",
+                        "self_transfer",
+                        "invalid_cursor",
+                        "invalid_page_limit",
+                        "account_not_found",
+                        "account_already_exists",
+                        "account_frozen",
+                        "account_closed",
+                        "invalid_account_status",
+                        "invalid_account_type",
+                        "invalid_overdraft_limit",
+                        "parent_not_found",
+                        "invalid_parent",
+                        "invalid_status_transition",
+                        "hold_not_found",
+                        "hold_not_active",
+                        "hold_expired",
+                        "invalid_hold_expiry",
+                        "capture_exceeds_hold",
+                        "webhook_not_found",
+                        "invalid_webhook_url"
+                    ]
+                },
+                "detail": {
+                    "description": "Explanation specific to this occurrence of the problem",
+                    "type": "string",
+                    "example": "insufficient funds"
+                },
+                "instance": {
+                    "description": "Request path where the problem occurred",
+                    "type": "string",
+                    "example": "/api/v1/account/123/transfers"
+                },
+                "status": {
+                    "description": "HTTP status code",
+                    "type": "integer",
+                    "example": 402
+                },
+                "title": {
+                    "description": "Short summary of the problem type",
+                    "type": "string",
+                    "example": "Payment Required"
+                },
+                "type": {
+                    "description": "URI reference identifying the problem type",
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "v1.ReverseTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount to reverse, all that is left of the transaction when omitted.\nOnly transactions with two legs can be partially reversed.",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.TransactionCreatedResponse": {
+            "description": "TransactionCreatedResponse is returned when money movements are booked.",
+            "type": "object",
+            "properties": {
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.TrialBalanceLine": {
+            "description": "TrialBalanceLine is the sum of the posted debits and credits of an account\nin a single currency.",
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "credits": {
+                    "description": "Credits in minor units",
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code",
+                    "type": "string"
+                },
+                "debits": {
+                    "description": "Debits in minor units",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.TrialBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TrialBalanceLine"
+                    }
+                },
+                "balanced": {
+                    "description": "Whether the debits equal the credits in every currency",
+                    "type": "boolean"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TrialBalanceTotal"
+                    }
+                }
+            }
+        },
+        "v1.TrialBalanceTotal": {
+            "description": "TrialBalanceTotal is the sum of the debits and credits of every account in\na single currency.",
+            "type": "object",
+            "properties": {
+                "balanced": {
+                    "description": "Whether the debits equal the credits",
+                    "type": "boolean"
+                },
+                "credits": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code",
+                    "type": "string"
+                },
+                "debits": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.UnbalancedTransaction": {
+            "description": "UnbalancedTransaction is a transaction whose debits and credits in a\ncurrency differ.",
+            "type": "object",
+            "properties": {
+                "credits": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "ISO 4217 currency code",
+                    "type": "string"
+                },
+                "debits": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.UnbalancedTransactionsResponse": {
+            "type": "object",
+            "properties": {
+                "balanced": {
+                    "description": "Whether every transaction's legs net to zero",
+                    "type": "boolean"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.UnbalancedTransaction"
+                    }
+                }
+            }
+        },
+        "v1.UpdateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "owner": {
+                    "description": "New owner, unchanged when omitted",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "New status, unchanged when omitted. Closed accounts cannot be reopened.",
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "frozen",
+                        "closed"
+                    ]
+                }
+            }
+        },
+        "v1.WebhookDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.WebhookDeliveryResponse"
+                    }
+                }
+            }
+        },
+        "v1.WebhookDeliveryResponse": {
+            "description": "WebhookDeliveryResponse is the notification of an event to a webhook and\nthe outcome of its last attempt.",
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "description": "Error of the last attempt, omitted unless it failed",
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "description": "Response status code of the last attempt, omitted if there was no response",
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "description": "When the delivery is attempted next, omitted unless pending",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Dead deliveries failed every attempt and are no longer retried",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.WebhookDeliveryStatus"
+                        }
+                    ]
+                },
+                "transaction_id": {
+                    "type": "integer"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.WebhookResponse": {
+            "description": "WebhookResponse is a subscription to the transactions posted on an account.",
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "description": "Key signing the deliveries, only returned when the webhook is created",
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.WebhookStatus"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.WebhooksResponse": {
+            "type": "object",
+            "properties": {
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.WebhookResponse"
+                    }
                 }
             }
         }
@@ -0,0 +1,25 @@
+// Package worker runs the background jobs of the service.
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// HoldExpirer marks the holds past their expiry as expired.
+type HoldExpirer interface {
+	ExpireHolds(ctx context.Context) (int64, error)
+}
+
+// RunHoldExpiry expires lapsed holds every interval until ctx is done.
+// Failures are logged and retried on the next tick.
+func RunHoldExpiry(ctx context.Context, uc HoldExpirer, interval time.Duration) {
+	run(ctx, interval, "expire holds", func(ctx context.Context) error {
+		n, err := uc.ExpireHolds(ctx)
+		if n > 0 {
+			log.Printf("Expired %d holds", n)
+		}
+		return err
+	})
+}
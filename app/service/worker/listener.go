@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Listener relays database notifications until ctx is done or its
+// connection fails.
+type Listener interface {
+	Listen(ctx context.Context) error
+}
+
+// RunListener keeps the listener running until ctx is done, listening again
+// retry after each failure, which is logged.
+func RunListener(ctx context.Context, l Listener, retry time.Duration) {
+	for {
+		err := l.Listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Unable to listen for notifications: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
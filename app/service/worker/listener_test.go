@@ -0,0 +1,45 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/app/service/worker"
+	"github.com/stretchr/testify/assert"
+)
+
+type listenerFunc func(ctx context.Context) error
+
+func (f listenerFunc) Listen(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestRunListener(t *testing.T) {
+	var calls atomic.Int64
+	ctx, cancel := context.WithCancel(context.Background())
+	l := listenerFunc(func(ctx context.Context) error {
+		// Listen again after a failure
+		if calls.Add(1) < 3 {
+			return errors.New("mocked error")
+		}
+		cancel()
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	done := make(chan struct{})
+	go func() {
+		worker.RunListener(ctx, l, time.Millisecond)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunListener did not stop when the context was cancelled")
+	}
+	assert.Equal(t, int64(3), calls.Load())
+}
@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// EventRelayer publishes the events of the outbox.
+type EventRelayer interface {
+	RelayEvents(ctx context.Context) (int64, error)
+}
+
+// RunOutboxRelay drains the outbox every interval until ctx is done.
+// Failures are logged and the unpublished events retried on the next tick.
+func RunOutboxRelay(ctx context.Context, uc EventRelayer, interval time.Duration) {
+	run(ctx, interval, "relay events", func(ctx context.Context) error {
+		n, err := uc.RelayEvents(ctx)
+		if n > 0 {
+			log.Printf("Relayed %d events", n)
+		}
+		return err
+	})
+}
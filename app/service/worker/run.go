@@ -0,0 +1,30 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// run calls job every interval until ctx is done. Failures are logged as
+// "Unable to <name>" and job is called again on the next tick.
+func run(ctx context.Context, interval time.Duration, name string, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Both may be ready at once.
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err := job(ctx); err != nil {
+				log.Printf("Unable to %s: %v", name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		// errs are returned by the successive calls of the job, which
+		// cancels the context on the last one. The context is cancelled
+		// before running when there are none.
+		errs          []error
+		expectedCalls int64
+	}{
+		{
+			name:          "Runs every interval",
+			interval:      time.Millisecond,
+			errs:          []error{nil, nil, nil},
+			expectedCalls: 3,
+		},
+		{
+			name:          "Keeps running after failures",
+			interval:      time.Millisecond,
+			errs:          []error{errors.New("mocked error"), errors.New("mocked error"), nil},
+			expectedCalls: 3,
+		},
+		{
+			name:          "Stops when cancelled",
+			interval:      time.Hour,
+			expectedCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int64
+			ctx, cancel := context.WithCancel(context.Background())
+			if len(tt.errs) == 0 {
+				cancel()
+			}
+
+			job := func(ctx context.Context) error {
+				n := calls.Add(1)
+				if n == int64(len(tt.errs)) {
+					cancel()
+				}
+				return tt.errs[n-1]
+			}
+
+			done := make(chan struct{})
+			go func() {
+				run(ctx, tt.interval, "run job", job)
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("run did not stop when the context was cancelled")
+			}
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+		})
+	}
+}
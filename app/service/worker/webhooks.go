@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// WebhookDeliverer pushes the due webhook deliveries.
+type WebhookDeliverer interface {
+	DeliverWebhooks(ctx context.Context) (int64, error)
+}
+
+// RunWebhookDelivery attempts the due webhook deliveries every interval until
+// ctx is done. Failures are logged; failed deliveries are retried once their
+// backoff is over.
+func RunWebhookDelivery(ctx context.Context, uc WebhookDeliverer, interval time.Duration) {
+	run(ctx, interval, "deliver webhooks", func(ctx context.Context) error {
+		n, err := uc.DeliverWebhooks(ctx)
+		if n > 0 {
+			log.Printf("Delivered %d webhooks", n)
+		}
+		return err
+	})
+}
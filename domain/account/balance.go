@@ -1,9 +1,24 @@
 package account
 
-import "context"
+import (
+	"context"
+	"time"
 
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// BalanceRepository returns the balance of an account from the entries
+// created up to asOf, or from all of them when asOf is nil. Balances are on
+// the account's normal side, so they are positive when it holds funds
+// whatever its type.
 type BalanceRepository interface {
-	Balance(ctx context.Context, account string) (int64, error)
+	// Balance fails with domain.ErrAccountNotFound unless the account is
+	// registered.
+	Balance(ctx context.Context, account string, asOf *time.Time) ([]entities.Balance, error)
+
+	// SubtreeBalance is like Balance, but adds up the balances of the account
+	// and all of its descendants.
+	SubtreeBalance(ctx context.Context, account string, asOf *time.Time) ([]entities.Balance, error)
 }
 
 type BalanceUseCase struct {
@@ -14,6 +29,23 @@ func NewBalanceUseCase(repo BalanceRepository) *BalanceUseCase {
 	return &BalanceUseCase{repo}
 }
 
-func (uc *BalanceUseCase) Balance(ctx context.Context, account string) (int64, error) {
-	return uc.repo.Balance(ctx, account)
+type BalanceInput struct {
+	Account string
+
+	// AsOf, when set, computes the balance at that point in time instead of
+	// the current one.
+	AsOf *time.Time
+
+	// IncludeChildren rolls the balances of the account's descendants up
+	// into its own.
+	IncludeChildren bool
+}
+
+// Balance returns the balance of the account in each currency it holds.
+func (uc *BalanceUseCase) Balance(ctx context.Context, input BalanceInput) ([]entities.Balance, error) {
+	if input.IncludeChildren {
+		return uc.repo.SubtreeBalance(ctx, input.Account, input.AsOf)
+	}
+
+	return uc.repo.Balance(ctx, input.Account, input.AsOf)
 }
@@ -4,17 +4,31 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/julioc98/ledger/domain/entities"
 	"github.com/stretchr/testify/assert"
 )
 
-type mockBalanceRepository struct{}
+type mockBalanceRepository struct {
+	asOf    *time.Time
+	subtree bool
+}
 
-func (m *mockBalanceRepository) Balance(ctx context.Context, account string) (int64, error) {
+func (m *mockBalanceRepository) Balance(ctx context.Context, account string, asOf *time.Time) ([]entities.Balance, error) {
+	m.asOf = asOf
 	if account == "validAccount" {
-		return 1000, nil
+		return []entities.Balance{
+			{Currency: entities.Currency{Code: "EUR", Exponent: 2}, Amount: 500},
+			{Currency: entities.Currency{Code: "USD", Exponent: 2}, Amount: 1000},
+		}, nil
 	}
-	return 0, errors.New("account not found")
+	return nil, errors.New("account not found")
+}
+
+func (m *mockBalanceRepository) SubtreeBalance(ctx context.Context, account string, asOf *time.Time) ([]entities.Balance, error) {
+	m.subtree = true
+	return m.Balance(ctx, account, asOf)
 }
 
 func TestBalanceUseCase_Balance(t *testing.T) {
@@ -24,16 +38,34 @@ func TestBalanceUseCase_Balance(t *testing.T) {
 	// Test case 1: Valid account
 	ctx := context.Background()
 	account := "validAccount"
-	expectedBalance := int64(1000)
+	expectedBalance := []entities.Balance{
+		{Currency: entities.Currency{Code: "EUR", Exponent: 2}, Amount: 500},
+		{Currency: entities.Currency{Code: "USD", Exponent: 2}, Amount: 1000},
+	}
 
-	balance, err := useCase.Balance(ctx, account)
+	balance, err := useCase.Balance(ctx, BalanceInput{Account: account})
 	assert.NoError(t, err, "unexpected error")
 	assert.Equal(t, expectedBalance, balance, "unexpected balance value")
+	assert.Nil(t, repo.asOf, "expected the current balance")
+
+	// Test case 2: Point-in-time balance
+	asOf := time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC)
+
+	_, err = useCase.Balance(ctx, BalanceInput{Account: account, AsOf: &asOf})
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, &asOf, repo.asOf, "unexpected as-of time")
+
+	// Test case 3: Roll-up of the account's subtree
+	assert.False(t, repo.subtree, "expected the account's own balance")
+
+	_, err = useCase.Balance(ctx, BalanceInput{Account: account, IncludeChildren: true})
+	assert.NoError(t, err, "unexpected error")
+	assert.True(t, repo.subtree, "expected the subtree balance")
 
-	// Test case 2: Invalid account
+	// Test case 4: Invalid account
 	account = "invalidAccount"
 
-	balance, err = useCase.Balance(ctx, account)
+	balance, err = useCase.Balance(ctx, BalanceInput{Account: account})
 	assert.Error(t, err, "expected error")
-	assert.Zero(t, balance, "expected balance 0")
+	assert.Empty(t, balance, "expected no balances")
 }
@@ -0,0 +1,71 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// CaptureHoldRepository locks a hold, lets capture settle it and books the
+// double entry capture returns like TransferRepository does, with the hold's
+// funds available to it. It fails with domain.ErrHoldNotFound if there is no
+// hold with the ID. The hold must stay locked until both are stored, so a
+// hold is captured at most once.
+type CaptureHoldRepository interface {
+	CaptureHold(ctx context.Context, id int64, capture func(*entities.Hold) (*entities.DoubleEntry, error)) (*entities.Hold, error)
+}
+
+// CaptureHoldUseCase is a use case for settling a hold with a transfer.
+type CaptureHoldUseCase struct {
+	repo CaptureHoldRepository
+}
+
+// NewCaptureHoldUseCase creates a new CaptureHoldUseCase.
+func NewCaptureHoldUseCase(repo CaptureHoldRepository) *CaptureHoldUseCase {
+	return &CaptureHoldUseCase{repo}
+}
+
+type CaptureHoldInput struct {
+	HoldID    int64
+	ToAccount string
+
+	// Amount is the amount to transfer, the whole held amount when zero.
+	Amount int64
+}
+
+// CaptureHold transfers up to the held amount from the hold's account to
+// ToAccount and releases the rest of the hold.
+func (uc *CaptureHoldUseCase) CaptureHold(ctx context.Context, input CaptureHoldInput) (*entities.Hold, error) {
+	if err := entities.ValidateAccount(input.ToAccount); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.CaptureHold(ctx, input.HoldID, func(h *entities.Hold) (*entities.DoubleEntry, error) {
+		if h.Account == input.ToAccount {
+			return nil, domain.ErrSelfTransfer
+		}
+
+		amount := input.Amount
+		if amount == 0 {
+			amount = h.Amount
+		}
+
+		if err := h.Capture(amount, time.Now()); err != nil {
+			return nil, err
+		}
+
+		credit, err := entities.NewEntry(0, h.Account, entities.Credit, amount, h.Currency, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		debit, err := entities.NewEntry(0, input.ToAccount, entities.Debit, amount, h.Currency, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return entities.NewDoubleEntry(*debit, *credit)
+	})
+}
@@ -0,0 +1,97 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCaptureHoldRepository struct {
+	hold    entities.Hold
+	entries *entities.DoubleEntry
+}
+
+func (m *mockCaptureHoldRepository) CaptureHold(ctx context.Context, id int64, capture func(*entities.Hold) (*entities.DoubleEntry, error)) (*entities.Hold, error) {
+	if id != m.hold.ID {
+		return nil, domain.ErrHoldNotFound
+	}
+
+	h := m.hold
+	de, err := capture(&h)
+	if err != nil {
+		return nil, err
+	}
+	m.entries = de
+	h.TransactionID = 1
+	return &h, nil
+}
+
+func TestCaptureHoldUseCase_CaptureHold(t *testing.T) {
+	usd := entities.Currency{Code: "USD", Exponent: 2}
+	active := entities.Hold{ID: 7, Account: "account1", Amount: 100, Currency: usd, Status: entities.HoldActive, ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		name        string
+		input       CaptureHoldInput
+		wantAmount  int64
+		wantErr     error
+		wantEntries bool
+	}{
+		{
+			name:        "should capture the whole hold",
+			input:       CaptureHoldInput{HoldID: 7, ToAccount: "merchant"},
+			wantAmount:  100,
+			wantEntries: true,
+		},
+		{
+			name:        "should capture part of the hold",
+			input:       CaptureHoldInput{HoldID: 7, ToAccount: "merchant", Amount: 40},
+			wantAmount:  40,
+			wantEntries: true,
+		},
+		{
+			name:    "should not capture more than held",
+			input:   CaptureHoldInput{HoldID: 7, ToAccount: "merchant", Amount: 101},
+			wantErr: domain.ErrCaptureExceedsHold,
+		},
+		{
+			name:    "should not capture into the held account",
+			input:   CaptureHoldInput{HoldID: 7, ToAccount: "account1"},
+			wantErr: domain.ErrSelfTransfer,
+		},
+		{
+			name:    "should reject invalid destination accounts",
+			input:   CaptureHoldInput{HoldID: 7, ToAccount: "mer chant"},
+			wantErr: domain.ErrInvalidAccount,
+		},
+		{
+			name:    "should fail for unknown holds",
+			input:   CaptureHoldInput{HoldID: 8, ToAccount: "merchant"},
+			wantErr: domain.ErrHoldNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockCaptureHoldRepository{hold: active}
+			got, err := NewCaptureHoldUseCase(repo).CaptureHold(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if !tt.wantEntries {
+				assert.Nil(t, got)
+				assert.Nil(t, repo.entries)
+				return
+			}
+
+			assert.Equal(t, entities.HoldCaptured, got.Status)
+			assert.Equal(t, tt.wantAmount, got.Captured)
+			assert.Equal(t, entities.DoubleEntry{
+				Credit: entities.Entry{Account: "account1", Direction: entities.Credit, Amount: tt.wantAmount, Currency: usd},
+				Debit:  entities.Entry{Account: "merchant", Direction: entities.Debit, Amount: tt.wantAmount, Currency: usd},
+			}, *repo.entries)
+		})
+	}
+}
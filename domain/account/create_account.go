@@ -0,0 +1,58 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// CreateAccountRepository registers an account, failing with
+// domain.ErrAccountAlreadyExists if the ID is taken. When the account has a
+// parent, it is attached with entities.Account.SetParent, failing with
+// domain.ErrParentNotFound if the parent is not registered.
+type CreateAccountRepository interface {
+	CreateAccount(ctx context.Context, account entities.Account) (*entities.Account, error)
+}
+
+// CreateAccountUseCase is a use case for registering an account.
+type CreateAccountUseCase struct {
+	repo CreateAccountRepository
+}
+
+// NewCreateAccountUseCase creates a new CreateAccountUseCase.
+func NewCreateAccountUseCase(repo CreateAccountRepository) *CreateAccountUseCase {
+	return &CreateAccountUseCase{repo}
+}
+
+type CreateAccountInput struct {
+	ID    string
+	Owner string
+
+	// Type is the account type, entities.Asset when empty.
+	Type string
+
+	// Parent is the ID of the account this one rolls up into, if any.
+	Parent string
+}
+
+// CreateAccount registers a new open account.
+func (uc *CreateAccountUseCase) CreateAccount(ctx context.Context, input CreateAccountInput) (*entities.Account, error) {
+	accountType := entities.Asset
+	if input.Type != "" {
+		accountType = entities.AccountType(input.Type)
+	}
+
+	a, err := entities.NewAccount(input.ID, input.Owner, accountType)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Parent != "" {
+		if err := entities.ValidateAccount(input.Parent); err != nil {
+			return nil, err
+		}
+		a.Parent = input.Parent
+	}
+
+	return uc.repo.CreateAccount(ctx, *a)
+}
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateAccountRepository struct {
+	created []entities.Account
+}
+
+func (m *mockCreateAccountRepository) CreateAccount(ctx context.Context, account entities.Account) (*entities.Account, error) {
+	for _, a := range m.created {
+		if a.ID == account.ID {
+			return nil, domain.ErrAccountAlreadyExists
+		}
+	}
+	m.created = append(m.created, account)
+	return &account, nil
+}
+
+func TestCreateAccountUseCase_CreateAccount(t *testing.T) {
+	repo := &mockCreateAccountRepository{
+		created: []entities.Account{{ID: "taken", Type: entities.Asset, Status: entities.AccountOpen}},
+	}
+	uc := NewCreateAccountUseCase(repo)
+
+	tests := []struct {
+		name    string
+		input   CreateAccountInput
+		want    *entities.Account
+		wantErr error
+	}{
+		{
+			name:  "should register an open asset account by default",
+			input: CreateAccountInput{ID: "account1", Owner: "customer-1"},
+			want:  &entities.Account{ID: "account1", Owner: "customer-1", Type: entities.Asset, Status: entities.AccountOpen},
+		},
+		{
+			name:  "should register an account of the given type",
+			input: CreateAccountInput{ID: "fees", Owner: "system", Type: "revenue"},
+			want:  &entities.Account{ID: "fees", Owner: "system", Type: entities.Revenue, Status: entities.AccountOpen},
+		},
+		{
+			name:  "should register a child account",
+			input: CreateAccountInput{ID: "assets:bank", Parent: "assets"},
+			want:  &entities.Account{ID: "assets:bank", Parent: "assets", Type: entities.Asset, Status: entities.AccountOpen},
+		},
+		{
+			name:    "should reject invalid parent IDs",
+			input:   CreateAccountInput{ID: "account3", Parent: "assets bank"},
+			wantErr: domain.ErrInvalidAccount,
+		},
+		{
+			name:    "should reject unknown types",
+			input:   CreateAccountInput{ID: "account2", Type: "income"},
+			wantErr: domain.ErrInvalidAccountType,
+		},
+		{
+			name:    "should reject taken IDs",
+			input:   CreateAccountInput{ID: "taken"},
+			wantErr: domain.ErrAccountAlreadyExists,
+		},
+		{
+			name:    "should reject invalid IDs",
+			input:   CreateAccountInput{ID: "account 1"},
+			wantErr: domain.ErrInvalidAccount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.CreateAccount(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// DefaultHoldExpiry is how long holds last when no expiry is given.
+const DefaultHoldExpiry = 7 * 24 * time.Hour
+
+// CreateHoldRepository stores an active hold, failing with
+// domain.ErrInsufficientFunds if the account's available balance would go
+// beyond its overdraft limit and with domain.ErrAccountNotFound,
+// domain.ErrAccountFrozen or domain.ErrAccountClosed unless the account is
+// registered and open. The checks and the write must be atomic.
+type CreateHoldRepository interface {
+	CreateHold(ctx context.Context, hold entities.Hold) (*entities.Hold, error)
+}
+
+// CreateHoldUseCase is a use case for reserving funds of an account.
+type CreateHoldUseCase struct {
+	repo CreateHoldRepository
+}
+
+// NewCreateHoldUseCase creates a new CreateHoldUseCase.
+func NewCreateHoldUseCase(repo CreateHoldRepository) *CreateHoldUseCase {
+	return &CreateHoldUseCase{repo}
+}
+
+type CreateHoldInput struct {
+	Account  string
+	Amount   int64
+	Currency string
+
+	// ExpiresAt is when the hold lapses, DefaultHoldExpiry from now when nil.
+	ExpiresAt *time.Time
+}
+
+// CreateHold reserves an amount of the account's funds until the hold is
+// captured, released or expires.
+func (uc *CreateHoldUseCase) CreateHold(ctx context.Context, input CreateHoldInput) (*entities.Hold, error) {
+	currency, err := entities.NewCurrency(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(DefaultHoldExpiry)
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+
+	h, err := entities.NewHold(input.Account, input.Amount, currency, expiresAt.UTC(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.CreateHold(ctx, *h)
+}
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateHoldRepository struct {
+	available int64
+	held      []entities.Hold
+}
+
+func (m *mockCreateHoldRepository) CreateHold(ctx context.Context, hold entities.Hold) (*entities.Hold, error) {
+	if hold.Amount > m.available {
+		return nil, domain.ErrInsufficientFunds
+	}
+	m.available -= hold.Amount
+
+	hold.ID = int64(len(m.held) + 1)
+	m.held = append(m.held, hold)
+	return &hold, nil
+}
+
+func TestCreateHoldUseCase_CreateHold(t *testing.T) {
+	usd := entities.Currency{Code: "USD", Exponent: 2}
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		input   CreateHoldInput
+		want    *entities.Hold
+		wantErr error
+	}{
+		{
+			name:  "should hold funds until the given expiry",
+			input: CreateHoldInput{Account: "account1", Amount: 60, Currency: "USD", ExpiresAt: &expiresAt},
+			want:  &entities.Hold{ID: 1, Account: "account1", Amount: 60, Currency: usd, Status: entities.HoldActive, ExpiresAt: expiresAt},
+		},
+		{
+			name:    "should not hold more than is available",
+			input:   CreateHoldInput{Account: "account1", Amount: 50, Currency: "USD", ExpiresAt: &expiresAt},
+			wantErr: domain.ErrInsufficientFunds,
+		},
+		{
+			name:    "should reject past expiries",
+			input:   CreateHoldInput{Account: "account1", Amount: 10, Currency: "USD", ExpiresAt: &past},
+			wantErr: domain.ErrInvalidHoldExpiry,
+		},
+		{
+			name:    "should reject non-positive amounts",
+			input:   CreateHoldInput{Account: "account1", Amount: -10, Currency: "USD"},
+			wantErr: domain.ErrInvalidAmount,
+		},
+		{
+			name:    "should reject unknown currencies",
+			input:   CreateHoldInput{Account: "account1", Amount: 10, Currency: "XYZ"},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+	}
+
+	repo := &mockCreateHoldRepository{available: 100}
+	uc := NewCreateHoldUseCase(repo)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.CreateHold(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("should default the expiry", func(t *testing.T) {
+		got, err := uc.CreateHold(context.Background(), CreateHoldInput{Account: "account1", Amount: 10, Currency: "USD"})
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(DefaultHoldExpiry), got.ExpiresAt, time.Minute)
+	})
+}
@@ -0,0 +1,43 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// CreateWebhookRepository stores an active webhook subscription, failing with
+// domain.ErrAccountNotFound unless its account is registered.
+type CreateWebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook entities.Webhook) (*entities.Webhook, error)
+}
+
+// CreateWebhookUseCase is a use case for subscribing to an account's
+// transactions.
+type CreateWebhookUseCase struct {
+	repo CreateWebhookRepository
+}
+
+// NewCreateWebhookUseCase creates a new CreateWebhookUseCase.
+func NewCreateWebhookUseCase(repo CreateWebhookRepository) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{repo}
+}
+
+type CreateWebhookInput struct {
+	Account string
+	URL     string
+
+	// Secret signs the deliveries, a random one is generated when empty.
+	Secret string
+}
+
+// CreateWebhook subscribes the URL to the transactions posted on the
+// account from now on.
+func (uc *CreateWebhookUseCase) CreateWebhook(ctx context.Context, input CreateWebhookInput) (*entities.Webhook, error) {
+	w, err := entities.NewWebhook(input.Account, input.URL, input.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.CreateWebhook(ctx, *w)
+}
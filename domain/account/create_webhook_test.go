@@ -0,0 +1,66 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCreateWebhookRepository struct {
+	accounts map[string]bool
+	webhooks []entities.Webhook
+}
+
+func (m *mockCreateWebhookRepository) CreateWebhook(ctx context.Context, webhook entities.Webhook) (*entities.Webhook, error) {
+	if !m.accounts[webhook.Account] {
+		return nil, domain.ErrAccountNotFound
+	}
+
+	webhook.ID = int64(len(m.webhooks) + 1)
+	m.webhooks = append(m.webhooks, webhook)
+	return &webhook, nil
+}
+
+func TestCreateWebhookUseCase_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CreateWebhookInput
+		want    *entities.Webhook
+		wantErr error
+	}{
+		{
+			name:  "should subscribe the URL to the account",
+			input: CreateWebhookInput{Account: "account1", URL: "https://partner.example/hooks", Secret: "whsec"},
+			want:  &entities.Webhook{ID: 1, Account: "account1", URL: "https://partner.example/hooks", Secret: "whsec", Status: entities.WebhookActive},
+		},
+		{
+			name:    "should reject unknown accounts",
+			input:   CreateWebhookInput{Account: "account2", URL: "https://partner.example/hooks"},
+			wantErr: domain.ErrAccountNotFound,
+		},
+		{
+			name:    "should reject invalid URLs",
+			input:   CreateWebhookInput{Account: "account1", URL: "partner.example/hooks"},
+			wantErr: domain.ErrInvalidWebhookURL,
+		},
+	}
+
+	repo := &mockCreateWebhookRepository{accounts: map[string]bool{"account1": true}}
+	uc := NewCreateWebhookUseCase(repo)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.CreateWebhook(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("should generate a secret", func(t *testing.T) {
+		got, err := uc.CreateWebhook(context.Background(), CreateWebhookInput{Account: "account1", URL: "https://partner.example/hooks"})
+		assert.NoError(t, err)
+		assert.Len(t, got.Secret, 64)
+	})
+}
@@ -0,0 +1,30 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// DeleteWebhookRepository disables a webhook subscription and returns it,
+// failing with domain.ErrWebhookNotFound if it does not exist.
+type DeleteWebhookRepository interface {
+	DisableWebhook(ctx context.Context, id int64) (*entities.Webhook, error)
+}
+
+// DeleteWebhookUseCase is a use case for unsubscribing a webhook.
+type DeleteWebhookUseCase struct {
+	repo DeleteWebhookRepository
+}
+
+// NewDeleteWebhookUseCase creates a new DeleteWebhookUseCase.
+func NewDeleteWebhookUseCase(repo DeleteWebhookRepository) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{repo}
+}
+
+// DeleteWebhook stops notifying the subscription, including the deliveries
+// still being retried. The subscription is disabled rather than removed, so
+// its delivery log is kept.
+func (uc *DeleteWebhookUseCase) DeleteWebhook(ctx context.Context, id int64) (*entities.Webhook, error) {
+	return uc.repo.DisableWebhook(ctx, id)
+}
@@ -0,0 +1,57 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeleteWebhookRepository struct {
+	webhooks map[int64]entities.Webhook
+}
+
+func (m *mockDeleteWebhookRepository) DisableWebhook(ctx context.Context, id int64) (*entities.Webhook, error) {
+	w, ok := m.webhooks[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	w.Status = entities.WebhookDisabled
+	m.webhooks[id] = w
+	return &w, nil
+}
+
+func TestDeleteWebhookUseCase_DeleteWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		want    *entities.Webhook
+		wantErr error
+	}{
+		{
+			name: "should disable the subscription",
+			id:   1,
+			want: &entities.Webhook{ID: 1, Account: "account1", URL: "https://a.example/hooks", Status: entities.WebhookDisabled},
+		},
+		{
+			name:    "should return not found",
+			id:      2,
+			wantErr: domain.ErrWebhookNotFound,
+		},
+	}
+
+	repo := &mockDeleteWebhookRepository{webhooks: map[int64]entities.Webhook{
+		1: {ID: 1, Account: "account1", URL: "https://a.example/hooks", Status: entities.WebhookActive},
+	}}
+	uc := NewDeleteWebhookUseCase(repo)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.DeleteWebhook(context.Background(), tt.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+const (
+	// webhookBatchSize is the number of deliveries claimed at a time.
+	webhookBatchSize = 20
+	// webhookLease is how long claimed deliveries are hidden from other
+	// workers. It must outlast sending a whole batch.
+	webhookLease = 5 * time.Minute
+)
+
+// DeliverWebhooksRepository claims and records webhook deliveries.
+type DeliverWebhooksRepository interface {
+	// ClaimWebhookDeliveries returns up to limit pending deliveries of active
+	// subscriptions that are due, oldest first, and postpones their next
+	// attempt by lease so concurrent workers skip them.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error)
+
+	// RecordWebhookAttempt stores the outcome of an attempt at a delivery.
+	RecordWebhookAttempt(ctx context.Context, delivery entities.WebhookDelivery) error
+}
+
+// WebhookSender posts a delivery, signed with its subscription's secret, to
+// the subscription's URL. It returns the response status code, zero if there
+// was no response, and an error unless the status code is 2xx.
+type WebhookSender interface {
+	Send(ctx context.Context, delivery entities.WebhookDelivery) (int, error)
+}
+
+// DeliverWebhooksUseCase is a use case for pushing the pending webhook
+// deliveries.
+type DeliverWebhooksUseCase struct {
+	repo   DeliverWebhooksRepository
+	sender WebhookSender
+}
+
+// NewDeliverWebhooksUseCase creates a new DeliverWebhooksUseCase.
+func NewDeliverWebhooksUseCase(repo DeliverWebhooksRepository, sender WebhookSender) *DeliverWebhooksUseCase {
+	return &DeliverWebhooksUseCase{repo, sender}
+}
+
+// DeliverWebhooks attempts the due deliveries, a batch at a time, until none
+// is left, and returns how many succeeded. Failed deliveries are retried with
+// an exponential backoff until they run out of attempts and are dead.
+func (uc *DeliverWebhooksUseCase) DeliverWebhooks(ctx context.Context) (int64, error) {
+	var delivered int64
+	for {
+		deliveries, err := uc.repo.ClaimWebhookDeliveries(ctx, webhookBatchSize, webhookLease)
+		if err != nil {
+			return delivered, err
+		}
+
+		for _, d := range deliveries {
+			statusCode, err := uc.sender.Send(ctx, d)
+			if err != nil {
+				d.Fail(time.Now(), statusCode, err)
+			} else {
+				d.Succeed(time.Now(), statusCode)
+				delivered++
+			}
+
+			err = uc.repo.RecordWebhookAttempt(ctx, d)
+			if err != nil {
+				return delivered, err
+			}
+		}
+
+		if len(deliveries) < webhookBatchSize {
+			return delivered, nil
+		}
+	}
+}
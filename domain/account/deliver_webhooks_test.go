@@ -0,0 +1,113 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDeliverWebhooksRepository struct {
+	pending  []entities.WebhookDelivery
+	recorded []entities.WebhookDelivery
+	err      error
+}
+
+func (m *mockDeliverWebhooksRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	batch := m.pending
+	if len(batch) > limit {
+		batch = batch[:limit]
+	}
+	m.pending = m.pending[len(batch):]
+
+	return batch, nil
+}
+
+func (m *mockDeliverWebhooksRepository) RecordWebhookAttempt(ctx context.Context, delivery entities.WebhookDelivery) error {
+	m.recorded = append(m.recorded, delivery)
+	return nil
+}
+
+type mockWebhookSender struct {
+	failing map[int64]int
+}
+
+func (m *mockWebhookSender) Send(ctx context.Context, delivery entities.WebhookDelivery) (int, error) {
+	if statusCode, ok := m.failing[delivery.ID]; ok {
+		return statusCode, assert.AnError
+	}
+
+	return 200, nil
+}
+
+func TestDeliverWebhooksUseCase_DeliverWebhooks(t *testing.T) {
+	pending := func(n int) []entities.WebhookDelivery {
+		deliveries := make([]entities.WebhookDelivery, 0, n)
+		for i := 1; i <= n; i++ {
+			deliveries = append(deliveries, entities.WebhookDelivery{ID: int64(i), Status: entities.WebhookDeliveryPending})
+		}
+		return deliveries
+	}
+
+	tests := []struct {
+		name          string
+		repo          *mockDeliverWebhooksRepository
+		sender        *mockWebhookSender
+		want          int64
+		wantRecorded  int
+		wantSucceeded int
+		wantErr       error
+	}{
+		{
+			name:          "should deliver every batch",
+			repo:          &mockDeliverWebhooksRepository{pending: pending(webhookBatchSize + 5)},
+			sender:        &mockWebhookSender{},
+			want:          webhookBatchSize + 5,
+			wantRecorded:  webhookBatchSize + 5,
+			wantSucceeded: webhookBatchSize + 5,
+		},
+		{
+			name:          "should record failed attempts",
+			repo:          &mockDeliverWebhooksRepository{pending: pending(3)},
+			sender:        &mockWebhookSender{failing: map[int64]int{2: 500}},
+			want:          2,
+			wantRecorded:  3,
+			wantSucceeded: 2,
+		},
+		{
+			name:    "should return repository errors",
+			repo:    &mockDeliverWebhooksRepository{err: assert.AnError},
+			sender:  &mockWebhookSender{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewDeliverWebhooksUseCase(tt.repo, tt.sender)
+			got, err := uc.DeliverWebhooks(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, tt.repo.recorded, tt.wantRecorded)
+
+			succeeded := 0
+			for _, d := range tt.repo.recorded {
+				assert.Equal(t, 1, d.Attempts)
+				if d.Status == entities.WebhookDeliverySucceeded {
+					succeeded++
+					continue
+				}
+				assert.Equal(t, entities.WebhookDeliveryPending, d.Status)
+				assert.Equal(t, 500, d.LastStatusCode)
+				assert.NotZero(t, d.NextAttemptAt)
+			}
+			assert.Equal(t, tt.wantSucceeded, succeeded)
+		})
+	}
+}
@@ -10,8 +10,11 @@ const (
 	ExternalAccount = "external"
 )
 
+// DepositRepository books a double entry, failing with
+// domain.ErrAccountNotFound, domain.ErrAccountFrozen or domain.ErrAccountClosed
+// unless both accounts are registered and open.
 type DepositRepository interface {
-	Transfer(ctx context.Context, entries entities.DoubleEntry) error
+	Transfer(ctx context.Context, entries entities.DoubleEntry) (int64, error)
 }
 
 // DepositUseCase is a use case for depositing money into an account.
@@ -25,26 +28,36 @@ func NewDepositUseCase(repo DepositRepository) *DepositUseCase {
 }
 
 type DepositInput struct {
-	Account string
-	Amount  int64
+	Account  string
+	Amount   int64
+	Currency string
+
+	// IdempotencyKey, when set, makes retrying the deposit safe.
+	IdempotencyKey string
 }
 
-// Deposit deposits money into an account.
-func (uc *DepositUseCase) Deposit(ctx context.Context, input DepositInput) error {
-	debit, err := entities.NewEntry(0, input.Account, entities.Debit, input.Amount, nil)
+// Deposit deposits money into an account and returns the transaction ID.
+func (uc *DepositUseCase) Deposit(ctx context.Context, input DepositInput) (int64, error) {
+	currency, err := entities.NewCurrency(input.Currency)
+	if err != nil {
+		return 0, err
+	}
+
+	debit, err := entities.NewEntry(0, input.Account, entities.Debit, input.Amount, currency, nil)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	credit, err := entities.NewEntry(0, ExternalAccount, entities.Credit, input.Amount, nil)
+	credit, err := entities.NewEntry(0, ExternalAccount, entities.Credit, input.Amount, currency, nil)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	de, err := entities.NewDoubleEntry(*debit, *credit)
 	if err != nil {
-		return err
+		return 0, err
 	}
+	de.IdempotencyKey = input.IdempotencyKey
 
 	return uc.repo.Transfer(ctx, *de)
 }
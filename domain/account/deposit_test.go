@@ -4,23 +4,28 @@ import (
 	"context"
 	"testing"
 
+	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/entities"
 	"github.com/stretchr/testify/assert"
 )
 
 type mockDepositRepository struct {
-	transferFunc func(ctx context.Context, entries entities.DoubleEntry) error
+	transferFunc func(ctx context.Context, entries entities.DoubleEntry) (int64, error)
 }
 
-func (m *mockDepositRepository) Transfer(ctx context.Context, entries entities.DoubleEntry) error {
+func (m *mockDepositRepository) Transfer(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
 	return m.transferFunc(ctx, entries)
 }
 
 func TestDepositUseCase_Deposit(t *testing.T) {
+	var booked entities.DoubleEntry
 	mockRepo := &mockDepositRepository{
-		transferFunc: func(ctx context.Context, entries entities.DoubleEntry) error {
-
-			return nil
+		transferFunc: func(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+			if entries.Debit.Account == "unknown" {
+				return 0, domain.ErrAccountNotFound
+			}
+			booked = entries
+			return 1, nil
 		},
 	}
 
@@ -29,22 +34,80 @@ func TestDepositUseCase_Deposit(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    DepositInput
+		wantID   int64
 		expected error
 	}{
 		{
 			name: "should deposit money into an account",
 			input: DepositInput{
-				Account: "123456789",
-				Amount:  1000,
+				Account:  "123456789",
+				Amount:   1000,
+				Currency: "USD",
+			},
+			wantID:   1,
+			expected: nil,
+		},
+		{
+			name: "should pass the idempotency key to the repository",
+			input: DepositInput{
+				Account:        "123456789",
+				Amount:         1000,
+				Currency:       "USD",
+				IdempotencyKey: "key-1",
 			},
+			wantID:   1,
 			expected: nil,
 		},
+		{
+			name: "should reject unknown currencies",
+			input: DepositInput{
+				Account:  "123456789",
+				Amount:   1000,
+				Currency: "XYZ",
+			},
+			wantID:   0,
+			expected: domain.ErrInvalidCurrency,
+		},
+		{
+			name: "should reject unregistered accounts",
+			input: DepositInput{
+				Account:  "unknown",
+				Amount:   1000,
+				Currency: "USD",
+			},
+			wantID:   0,
+			expected: domain.ErrAccountNotFound,
+		},
+		{
+			name: "should reject negative amounts",
+			input: DepositInput{
+				Account:  "123456789",
+				Amount:   -500,
+				Currency: "USD",
+			},
+			wantID:   0,
+			expected: domain.ErrInvalidAmount,
+		},
+		{
+			name: "should reject empty accounts",
+			input: DepositInput{
+				Amount:   1000,
+				Currency: "USD",
+			},
+			wantID:   0,
+			expected: domain.ErrEmptyAccount,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := uc.Deposit(context.Background(), tt.input)
+			id, err := uc.Deposit(context.Background(), tt.input)
 			assert.ErrorIs(t, err, tt.expected, "unexpected error")
+			assert.Equal(t, tt.wantID, id, "unexpected transaction ID")
+			if tt.expected == nil {
+				assert.Equal(t, tt.input.IdempotencyKey, booked.IdempotencyKey, "unexpected idempotency key")
+				assert.Equal(t, tt.input.Currency, booked.Debit.Currency.Code, "unexpected currency")
+			}
 		})
 	}
 }
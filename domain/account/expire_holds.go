@@ -0,0 +1,29 @@
+package account
+
+import (
+	"context"
+)
+
+// ExpireHoldsRepository marks the active holds past their expiry as expired
+// and returns how many there were.
+type ExpireHoldsRepository interface {
+	ExpireHolds(ctx context.Context) (int64, error)
+}
+
+// ExpireHoldsUseCase is a use case for settling holds that lapsed. Expired
+// holds stop reserving funds when they expire whether or not this has run;
+// it only records that they lapsed.
+type ExpireHoldsUseCase struct {
+	repo ExpireHoldsRepository
+}
+
+// NewExpireHoldsUseCase creates a new ExpireHoldsUseCase.
+func NewExpireHoldsUseCase(repo ExpireHoldsRepository) *ExpireHoldsUseCase {
+	return &ExpireHoldsUseCase{repo}
+}
+
+// ExpireHolds marks every lapsed hold as expired and returns how many there
+// were.
+func (uc *ExpireHoldsUseCase) ExpireHolds(ctx context.Context) (int64, error) {
+	return uc.repo.ExpireHolds(ctx)
+}
@@ -0,0 +1,26 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockExpireHoldsRepository struct {
+	expired int64
+	err     error
+}
+
+func (m *mockExpireHoldsRepository) ExpireHolds(ctx context.Context) (int64, error) {
+	return m.expired, m.err
+}
+
+func TestExpireHoldsUseCase_ExpireHolds(t *testing.T) {
+	got, err := NewExpireHoldsUseCase(&mockExpireHoldsRepository{expired: 3}).ExpireHolds(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), got)
+
+	_, err = NewExpireHoldsUseCase(&mockExpireHoldsRepository{err: assert.AnError}).ExpireHolds(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,28 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// GetAccountRepository returns a registered account, failing with
+// domain.ErrAccountNotFound if there is none with the ID.
+type GetAccountRepository interface {
+	Account(ctx context.Context, id string) (*entities.Account, error)
+}
+
+// GetAccountUseCase is a use case for reading a registered account.
+type GetAccountUseCase struct {
+	repo GetAccountRepository
+}
+
+// NewGetAccountUseCase creates a new GetAccountUseCase.
+func NewGetAccountUseCase(repo GetAccountRepository) *GetAccountUseCase {
+	return &GetAccountUseCase{repo}
+}
+
+// GetAccount returns the account with the given ID.
+func (uc *GetAccountUseCase) GetAccount(ctx context.Context, id string) (*entities.Account, error) {
+	return uc.repo.Account(ctx, id)
+}
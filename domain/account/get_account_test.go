@@ -0,0 +1,31 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGetAccountRepository struct{}
+
+func (m *mockGetAccountRepository) Account(ctx context.Context, id string) (*entities.Account, error) {
+	if id == "account1" {
+		return &entities.Account{ID: "account1", Status: entities.AccountOpen}, nil
+	}
+	return nil, domain.ErrAccountNotFound
+}
+
+func TestGetAccountUseCase_GetAccount(t *testing.T) {
+	uc := NewGetAccountUseCase(&mockGetAccountRepository{})
+
+	got, err := uc.GetAccount(context.Background(), "account1")
+	assert.NoError(t, err)
+	assert.Equal(t, &entities.Account{ID: "account1", Status: entities.AccountOpen}, got)
+
+	got, err = uc.GetAccount(context.Background(), "unknown")
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,72 @@
+package account
+
+import (
+	"context"
+	"sort"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// JournalRepository books every leg of a journal entry, failing with
+// domain.ErrInsufficientFunds if the entry would take one of the checked
+// accounts beyond its overdraft limit, and with domain.ErrAccountNotFound,
+// domain.ErrAccountFrozen or domain.ErrAccountClosed unless every account is
+// registered and open.
+type JournalRepository interface {
+	Journal(ctx context.Context, je entities.JournalEntry, checked []string) (int64, error)
+}
+
+// JournalUseCase is a use case for booking a transaction with any number of legs.
+type JournalUseCase struct {
+	repo JournalRepository
+}
+
+// NewJournalUseCase creates a new JournalUseCase.
+func NewJournalUseCase(repo JournalRepository) *JournalUseCase {
+	return &JournalUseCase{repo}
+}
+
+type JournalLegInput struct {
+	Account   string
+	Direction entities.Direction
+	Amount    int64
+}
+
+type JournalInput struct {
+	Currency string
+	Legs     []JournalLegInput
+}
+
+// Journal books all legs atomically and returns the transaction ID. Every
+// account whose balance decreases must stay within its overdraft limit.
+func (uc *JournalUseCase) Journal(ctx context.Context, input JournalInput) (int64, error) {
+	currency, err := entities.NewCurrency(input.Currency)
+	if err != nil {
+		return 0, err
+	}
+
+	entries := make([]entities.Entry, 0, len(input.Legs))
+	for _, leg := range input.Legs {
+		e, err := entities.NewEntry(0, leg.Account, leg.Direction, leg.Amount, currency, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		entries = append(entries, *e)
+	}
+
+	je, err := entities.NewJournalEntry(entries...)
+	if err != nil {
+		return 0, err
+	}
+
+	// Only the repository knows the account types, and so which of the
+	// accounts decrease, so all of them are checked.
+	checked := make([]string, 0, len(je.Entries))
+	for account := range je.Net() {
+		checked = append(checked, account)
+	}
+	sort.Strings(checked)
+
+	return uc.repo.Journal(ctx, *je, checked)
+}
@@ -0,0 +1,106 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockJournalRepository struct {
+	journalFunc func(ctx context.Context, je entities.JournalEntry, checked []string) (int64, error)
+}
+
+func (m *mockJournalRepository) Journal(ctx context.Context, je entities.JournalEntry, checked []string) (int64, error) {
+	return m.journalFunc(ctx, je, checked)
+}
+
+func TestJournalUseCase_Journal(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       JournalInput
+		wantChecked []string
+		wantID      int64
+		wantErr     error
+	}{
+		{
+			name: "should book a payment with a fee split",
+			input: JournalInput{
+				Currency: "USD",
+				Legs: []JournalLegInput{
+					{Account: "payer", Direction: entities.Credit, Amount: 100},
+					{Account: "merchant", Direction: entities.Debit, Amount: 97},
+					{Account: "fees", Direction: entities.Debit, Amount: 3},
+				},
+			},
+			wantChecked: []string{"fees", "merchant", "payer"},
+			wantID:      1,
+			wantErr:     nil,
+		},
+		{
+			name: "should leave the external account to its overdraft limit",
+			input: JournalInput{
+				Currency: "USD",
+				Legs: []JournalLegInput{
+					{Account: ExternalAccount, Direction: entities.Credit, Amount: 100},
+					{Account: "account1", Direction: entities.Debit, Amount: 100},
+				},
+			},
+			wantChecked: []string{"account1", ExternalAccount},
+			wantID:      1,
+			wantErr:     nil,
+		},
+		{
+			name: "should reject unbalanced legs",
+			input: JournalInput{
+				Currency: "USD",
+				Legs: []JournalLegInput{
+					{Account: "payer", Direction: entities.Credit, Amount: 100},
+					{Account: "merchant", Direction: entities.Debit, Amount: 90},
+				},
+			},
+			wantErr: domain.ErrUnbalancedJournalEntry,
+		},
+		{
+			name: "should reject unknown currencies",
+			input: JournalInput{
+				Currency: "XYZ",
+				Legs: []JournalLegInput{
+					{Account: "payer", Direction: entities.Credit, Amount: 100},
+					{Account: "merchant", Direction: entities.Debit, Amount: 100},
+				},
+			},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+		{
+			name: "should reject invalid directions",
+			input: JournalInput{
+				Currency: "USD",
+				Legs: []JournalLegInput{
+					{Account: "payer", Direction: "sideways", Amount: 100},
+					{Account: "merchant", Direction: entities.Debit, Amount: 100},
+				},
+			},
+			wantErr: domain.ErrIvalidDirection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotChecked []string
+			uc := NewJournalUseCase(&mockJournalRepository{
+				journalFunc: func(ctx context.Context, je entities.JournalEntry, checked []string) (int64, error) {
+					gotChecked = checked
+					return 1, nil
+				},
+			})
+
+			id, err := uc.Journal(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantChecked, gotChecked)
+		})
+	}
+}
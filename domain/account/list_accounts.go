@@ -0,0 +1,98 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// ListAccountsRepository returns up to query.Limit accounts matching the
+// query, ordered by ID.
+type ListAccountsRepository interface {
+	Accounts(ctx context.Context, query entities.AccountsQuery) ([]entities.Account, error)
+}
+
+// ListAccountsUseCase is a use case for listing registered accounts.
+type ListAccountsUseCase struct {
+	repo ListAccountsRepository
+}
+
+// NewListAccountsUseCase creates a new ListAccountsUseCase.
+func NewListAccountsUseCase(repo ListAccountsRepository) *ListAccountsUseCase {
+	return &ListAccountsUseCase{repo}
+}
+
+type ListAccountsInput struct {
+	// Owner, Parent, Type and Status keep only matching accounts when set.
+	Owner  string
+	Parent string
+	Type   string
+	Status string
+
+	// Cursor is the NextCursor of the previous page, empty for the first one.
+	Cursor string
+
+	// Limit is the page size, DefaultHistoryLimit when zero.
+	Limit int
+}
+
+type ListAccountsOutput struct {
+	Accounts []entities.Account
+
+	// NextCursor fetches the next page, empty on the last one.
+	NextCursor string
+}
+
+// ListAccounts returns a page of the registered accounts.
+func (uc *ListAccountsUseCase) ListAccounts(ctx context.Context, input ListAccountsInput) (*ListAccountsOutput, error) {
+	query := entities.AccountsQuery{
+		Owner:  input.Owner,
+		Parent: input.Parent,
+		After:  input.Cursor,
+		Limit:  input.Limit,
+	}
+
+	if input.Type != "" {
+		accountType, err := entities.NewAccountType(input.Type)
+		if err != nil {
+			return nil, err
+		}
+		query.Type = accountType
+	}
+
+	if input.Status != "" {
+		status, err := entities.NewAccountStatus(input.Status)
+		if err != nil {
+			return nil, err
+		}
+		query.Status = status
+	}
+
+	if query.Limit == 0 {
+		query.Limit = DefaultHistoryLimit
+	}
+
+	if query.Limit < 0 || query.Limit > MaxHistoryLimit {
+		return nil, domain.ErrInvalidPageLimit
+	}
+
+	// Ask for one more account than requested to know whether there is a next page.
+	query.Limit++
+	accounts, err := uc.repo.Accounts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListAccountsOutput{Accounts: accounts}
+	if len(accounts) == query.Limit {
+		output.Accounts = accounts[:len(accounts)-1]
+		output.NextCursor = output.Accounts[len(output.Accounts)-1].ID
+	}
+
+	if output.Accounts == nil {
+		output.Accounts = []entities.Account{}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,87 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockListAccountsRepository struct {
+	accounts []entities.Account
+	query    entities.AccountsQuery
+}
+
+func (m *mockListAccountsRepository) Accounts(ctx context.Context, query entities.AccountsQuery) ([]entities.Account, error) {
+	m.query = query
+
+	// Return at most query.Limit of the stored accounts, like the database does
+	if len(m.accounts) > query.Limit {
+		return m.accounts[:query.Limit], nil
+	}
+	return m.accounts, nil
+}
+
+func TestListAccountsUseCase_ListAccounts(t *testing.T) {
+	accounts := []entities.Account{
+		{ID: "account1", Owner: "customer-1", Status: entities.AccountOpen},
+		{ID: "account2", Owner: "customer-1", Status: entities.AccountOpen},
+		{ID: "account3", Owner: "customer-1", Status: entities.AccountOpen},
+	}
+
+	tests := []struct {
+		name           string
+		input          ListAccountsInput
+		wantAccounts   []entities.Account
+		wantNextCursor string
+		wantQuery      entities.AccountsQuery
+		wantErr        error
+	}{
+		{
+			name:         "should return all accounts in a single page",
+			input:        ListAccountsInput{Owner: "customer-1", Parent: "customers", Type: "asset", Status: "open"},
+			wantAccounts: accounts,
+			wantQuery:    entities.AccountsQuery{Owner: "customer-1", Parent: "customers", Type: entities.Asset, Status: entities.AccountOpen, Limit: DefaultHistoryLimit + 1},
+		},
+		{
+			name:           "should return a cursor when there are more accounts",
+			input:          ListAccountsInput{Cursor: "account0", Limit: 2},
+			wantAccounts:   accounts[:2],
+			wantNextCursor: "account2",
+			wantQuery:      entities.AccountsQuery{After: "account0", Limit: 3},
+		},
+		{
+			name:    "should reject unknown types",
+			input:   ListAccountsInput{Type: "income"},
+			wantErr: domain.ErrInvalidAccountType,
+		},
+		{
+			name:    "should reject unknown statuses",
+			input:   ListAccountsInput{Status: "deleted"},
+			wantErr: domain.ErrInvalidAccountStatus,
+		},
+		{
+			name:    "should reject limits above the maximum",
+			input:   ListAccountsInput{Limit: MaxHistoryLimit + 1},
+			wantErr: domain.ErrInvalidPageLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockListAccountsRepository{accounts: accounts}
+
+			output, err := NewListAccountsUseCase(repo).ListAccounts(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantAccounts, output.Accounts)
+			assert.Equal(t, tt.wantNextCursor, output.NextCursor)
+			assert.Equal(t, tt.wantQuery, repo.query)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// ListWebhooksRepository returns the webhook subscriptions of an account, or
+// of every account when it is empty, ordered by ID.
+type ListWebhooksRepository interface {
+	Webhooks(ctx context.Context, account string) ([]entities.Webhook, error)
+}
+
+// ListWebhooksUseCase is a use case for listing webhook subscriptions.
+type ListWebhooksUseCase struct {
+	repo ListWebhooksRepository
+}
+
+// NewListWebhooksUseCase creates a new ListWebhooksUseCase.
+func NewListWebhooksUseCase(repo ListWebhooksRepository) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{repo}
+}
+
+// ListWebhooks returns the webhook subscriptions of the account, or all of
+// them when account is empty, disabled ones included.
+func (uc *ListWebhooksUseCase) ListWebhooks(ctx context.Context, account string) ([]entities.Webhook, error) {
+	webhooks, err := uc.repo.Webhooks(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhooks == nil {
+		webhooks = []entities.Webhook{}
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,75 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockListWebhooksRepository struct {
+	webhooks []entities.Webhook
+	err      error
+}
+
+func (m *mockListWebhooksRepository) Webhooks(ctx context.Context, account string) ([]entities.Webhook, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	var webhooks []entities.Webhook
+	for _, w := range m.webhooks {
+		if account == "" || w.Account == account {
+			webhooks = append(webhooks, w)
+		}
+	}
+	return webhooks, nil
+}
+
+func TestListWebhooksUseCase_ListWebhooks(t *testing.T) {
+	webhooks := []entities.Webhook{
+		{ID: 1, Account: "account1", URL: "https://a.example/hooks", Status: entities.WebhookActive},
+		{ID: 2, Account: "account2", URL: "https://b.example/hooks", Status: entities.WebhookDisabled},
+	}
+
+	tests := []struct {
+		name    string
+		repo    *mockListWebhooksRepository
+		account string
+		want    []entities.Webhook
+		wantErr error
+	}{
+		{
+			name: "should list every subscription",
+			repo: &mockListWebhooksRepository{webhooks: webhooks},
+			want: webhooks,
+		},
+		{
+			name:    "should filter by account",
+			repo:    &mockListWebhooksRepository{webhooks: webhooks},
+			account: "account2",
+			want:    webhooks[1:],
+		},
+		{
+			name:    "should return an empty list",
+			repo:    &mockListWebhooksRepository{webhooks: webhooks},
+			account: "account3",
+			want:    []entities.Webhook{},
+		},
+		{
+			name:    "should return repository errors",
+			repo:    &mockListWebhooksRepository{err: assert.AnError},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewListWebhooksUseCase(tt.repo)
+			got, err := uc.ListWebhooks(context.Background(), tt.account)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
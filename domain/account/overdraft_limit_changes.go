@@ -0,0 +1,31 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// OverdraftLimitChangesRepository returns the overdraft limit changes of an
+// account, newest first, failing with domain.ErrAccountNotFound if there is no
+// account with the ID.
+type OverdraftLimitChangesRepository interface {
+	OverdraftLimitChanges(ctx context.Context, account string) ([]entities.OverdraftLimitChange, error)
+}
+
+// OverdraftLimitChangesUseCase is a use case for auditing the overdraft limit
+// of an account.
+type OverdraftLimitChangesUseCase struct {
+	repo OverdraftLimitChangesRepository
+}
+
+// NewOverdraftLimitChangesUseCase creates a new OverdraftLimitChangesUseCase.
+func NewOverdraftLimitChangesUseCase(repo OverdraftLimitChangesRepository) *OverdraftLimitChangesUseCase {
+	return &OverdraftLimitChangesUseCase{repo}
+}
+
+// OverdraftLimitChanges returns every change of the overdraft limit of the
+// account, newest first.
+func (uc *OverdraftLimitChangesUseCase) OverdraftLimitChanges(ctx context.Context, account string) ([]entities.OverdraftLimitChange, error) {
+	return uc.repo.OverdraftLimitChanges(ctx, account)
+}
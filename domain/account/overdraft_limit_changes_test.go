@@ -0,0 +1,31 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockOverdraftLimitChangesRepository struct{}
+
+func (m *mockOverdraftLimitChangesRepository) OverdraftLimitChanges(ctx context.Context, account string) ([]entities.OverdraftLimitChange, error) {
+	if account == "account1" {
+		return []entities.OverdraftLimitChange{{ID: 1, Account: "account1", OldLimit: 0, NewLimit: 500}}, nil
+	}
+	return nil, domain.ErrAccountNotFound
+}
+
+func TestOverdraftLimitChangesUseCase_OverdraftLimitChanges(t *testing.T) {
+	uc := NewOverdraftLimitChangesUseCase(&mockOverdraftLimitChangesRepository{})
+
+	got, err := uc.OverdraftLimitChanges(context.Background(), "account1")
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.OverdraftLimitChange{{ID: 1, Account: "account1", OldLimit: 0, NewLimit: 500}}, got)
+
+	got, err = uc.OverdraftLimitChanges(context.Background(), "unknown")
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound)
+	assert.Nil(t, got)
+}
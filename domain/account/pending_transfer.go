@@ -0,0 +1,38 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// PendingTransferRepository books a double entry in a pending transaction,
+// failing like TransferRepository does. The available balance of each account
+// must account for its pending transfers, so funds reserved by them cannot be
+// spent twice.
+type PendingTransferRepository interface {
+	PendingTransfer(ctx context.Context, entries entities.DoubleEntry) (int64, error)
+}
+
+// PendingTransferUseCase is a use case for the first phase of a two-phase
+// transfer.
+type PendingTransferUseCase struct {
+	repo PendingTransferRepository
+}
+
+// NewPendingTransferUseCase creates a new PendingTransferUseCase.
+func NewPendingTransferUseCase(repo PendingTransferRepository) *PendingTransferUseCase {
+	return &PendingTransferUseCase{repo}
+}
+
+// PendingTransfer reserves the funds of a transfer, to be moved when its
+// transaction is posted or released when it is voided, and returns the
+// transaction ID.
+func (uc *PendingTransferUseCase) PendingTransfer(ctx context.Context, input TransferInput) (int64, error) {
+	de, err := transferEntry(input)
+	if err != nil {
+		return 0, err
+	}
+
+	return uc.repo.PendingTransfer(ctx, *de)
+}
@@ -0,0 +1,63 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPendingTransferRepo struct {
+	entries []entities.DoubleEntry
+}
+
+func (m *mockPendingTransferRepo) PendingTransfer(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	m.entries = append(m.entries, entries)
+	return int64(len(m.entries)), nil
+}
+
+func TestPendingTransferUseCase_PendingTransfer(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         TransferInput
+		expectedError error
+		bookedCalls   int
+	}{
+		{
+			name:        "Valid transfer",
+			input:       TransferInput{FromAccount: "account1", ToAccount: "account2", Amount: 100, Currency: "USD", IdempotencyKey: "key"},
+			bookedCalls: 1,
+		},
+		{
+			name:          "Self transfer",
+			input:         TransferInput{FromAccount: "account1", ToAccount: "account1", Amount: 100, Currency: "USD"},
+			expectedError: domain.ErrSelfTransfer,
+		},
+		{
+			name:          "Invalid amount",
+			input:         TransferInput{FromAccount: "account1", ToAccount: "account2", Amount: 0, Currency: "USD"},
+			expectedError: domain.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockPendingTransferRepo{}
+			uc := NewPendingTransferUseCase(repo)
+
+			transactionID, err := uc.PendingTransfer(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Len(t, repo.entries, tt.bookedCalls)
+
+			if tt.bookedCalls > 0 {
+				assert.Equal(t, int64(1), transactionID)
+				de := repo.entries[0]
+				assert.Equal(t, "account1", de.Credit.Account)
+				assert.Equal(t, "account2", de.Debit.Account)
+				assert.Equal(t, tt.input.IdempotencyKey, de.IdempotencyKey)
+			}
+		})
+	}
+}
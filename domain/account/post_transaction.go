@@ -0,0 +1,34 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// PostTransactionRepository applies update to a transaction and stores the
+// result, failing with domain.ErrTransactionNotFound if there is none with the
+// ID. The transaction must stay locked while update runs, and the balances of
+// its accounts must be updated in the same transaction when it is posted.
+type PostTransactionRepository interface {
+	UpdateTransaction(ctx context.Context, id int64, update func(*entities.Transaction) error) (*entities.Transaction, error)
+}
+
+// PostTransactionUseCase is a use case for the second phase of a two-phase
+// transfer.
+type PostTransactionUseCase struct {
+	repo PostTransactionRepository
+}
+
+// NewPostTransactionUseCase creates a new PostTransactionUseCase.
+func NewPostTransactionUseCase(repo PostTransactionRepository) *PostTransactionUseCase {
+	return &PostTransactionUseCase{repo}
+}
+
+// PostTransaction makes a pending transaction final, moving the funds it
+// reserved.
+func (uc *PostTransactionUseCase) PostTransaction(ctx context.Context, id int64) (*entities.Transaction, error) {
+	return uc.repo.UpdateTransaction(ctx, id, func(t *entities.Transaction) error {
+		return t.Post()
+	})
+}
@@ -0,0 +1,55 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateTransactionRepository struct {
+	transaction entities.Transaction
+}
+
+func (m *mockUpdateTransactionRepository) UpdateTransaction(ctx context.Context, id int64, update func(*entities.Transaction) error) (*entities.Transaction, error) {
+	if id != m.transaction.ID {
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	t := m.transaction
+	t.Entries = append([]entities.Entry(nil), m.transaction.Entries...)
+	if err := update(&t); err != nil {
+		return nil, err
+	}
+	m.transaction = t
+	return &t, nil
+}
+
+func pendingTransaction() entities.Transaction {
+	return entities.Transaction{
+		ID:     7,
+		Status: entities.TransactionPending,
+		Entries: []entities.Entry{
+			{Account: "account1", Direction: entities.Credit, Amount: 100, Status: entities.TransactionPending},
+			{Account: "account2", Direction: entities.Debit, Amount: 100, Status: entities.TransactionPending},
+		},
+	}
+}
+
+func TestPostTransactionUseCase_PostTransaction(t *testing.T) {
+	repo := &mockUpdateTransactionRepository{transaction: pendingTransaction()}
+	uc := NewPostTransactionUseCase(repo)
+
+	got, err := uc.PostTransaction(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.TransactionPosted, got.Status)
+	assert.Equal(t, entities.TransactionPosted, got.Entries[0].Status)
+
+	_, err = uc.PostTransaction(context.Background(), 7)
+	assert.ErrorIs(t, err, domain.ErrTransactionNotPending)
+
+	_, err = uc.PostTransaction(context.Background(), 8)
+	assert.ErrorIs(t, err, domain.ErrTransactionNotFound)
+}
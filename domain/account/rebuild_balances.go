@@ -0,0 +1,29 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// RebuildBalancesRepository recomputes the stored balances from the entries
+// and returns the ones that were off.
+type RebuildBalancesRepository interface {
+	RebuildBalances(ctx context.Context) ([]entities.BalanceDrift, error)
+}
+
+// RebuildBalancesUseCase is a use case for repairing the stored balances.
+type RebuildBalancesUseCase struct {
+	repo RebuildBalancesRepository
+}
+
+// NewRebuildBalancesUseCase creates a new RebuildBalancesUseCase.
+func NewRebuildBalancesUseCase(repo RebuildBalancesRepository) *RebuildBalancesUseCase {
+	return &RebuildBalancesUseCase{repo}
+}
+
+// RebuildBalances recomputes every stored balance from the entries and
+// returns the drift it corrected.
+func (uc *RebuildBalancesUseCase) RebuildBalances(ctx context.Context) ([]entities.BalanceDrift, error) {
+	return uc.repo.RebuildBalances(ctx)
+}
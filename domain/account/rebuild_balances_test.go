@@ -0,0 +1,54 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRebuildBalancesRepository struct {
+	drift []entities.BalanceDrift
+	err   error
+}
+
+func (m *mockRebuildBalancesRepository) RebuildBalances(ctx context.Context) ([]entities.BalanceDrift, error) {
+	return m.drift, m.err
+}
+
+func TestRebuildBalancesUseCase_RebuildBalances(t *testing.T) {
+	drift := []entities.BalanceDrift{
+		{Account: "account1", Currency: entities.Currency{Code: "USD", Exponent: 2}, Stored: 100, Computed: 90},
+	}
+
+	tests := []struct {
+		name    string
+		repo    *mockRebuildBalancesRepository
+		want    []entities.BalanceDrift
+		wantErr error
+	}{
+		{
+			name: "should report the corrected drift",
+			repo: &mockRebuildBalancesRepository{drift: drift},
+			want: drift,
+		},
+		{
+			name: "should report no drift",
+			repo: &mockRebuildBalancesRepository{},
+		},
+		{
+			name:    "should return repository errors",
+			repo:    &mockRebuildBalancesRepository{err: assert.AnError},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRebuildBalancesUseCase(tt.repo).RebuildBalances(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
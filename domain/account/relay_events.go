@@ -0,0 +1,62 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// relayBatchSize is the number of events relayed at a time.
+const relayBatchSize = 100
+
+// RelayEventsRepository locks up to limit unpublished events of the outbox,
+// oldest first, skipping the ones locked by other relays, and calls publish
+// with them. The first n events publish reports are marked as published, even
+// when it also returns an error. It returns n.
+type RelayEventsRepository interface {
+	RelayEvents(ctx context.Context, limit int, publish func(events []entities.Event) (int, error)) (int, error)
+}
+
+// EventPublisher delivers an event to downstream services. It may be called
+// again with an event it already delivered, so consumers must tell
+// duplicates apart by the event ID.
+type EventPublisher interface {
+	Publish(ctx context.Context, event entities.Event) error
+}
+
+// RelayEventsUseCase is a use case for publishing the events of the outbox.
+type RelayEventsUseCase struct {
+	repo      RelayEventsRepository
+	publisher EventPublisher
+}
+
+// NewRelayEventsUseCase creates a new RelayEventsUseCase.
+func NewRelayEventsUseCase(repo RelayEventsRepository, publisher EventPublisher) *RelayEventsUseCase {
+	return &RelayEventsUseCase{repo, publisher}
+}
+
+// RelayEvents publishes the unpublished events in order, a batch at a time,
+// until the outbox is drained or publishing fails, and returns how many were
+// published. Events are only marked as published once the publisher accepted
+// them, so delivery is at least once.
+func (uc *RelayEventsUseCase) RelayEvents(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		n, err := uc.repo.RelayEvents(ctx, relayBatchSize, func(events []entities.Event) (int, error) {
+			for i, e := range events {
+				if err := uc.publisher.Publish(ctx, e); err != nil {
+					return i, err
+				}
+			}
+			return len(events), nil
+		})
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+
+		if n < relayBatchSize {
+			return total, nil
+		}
+	}
+}
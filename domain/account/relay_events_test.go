@@ -0,0 +1,108 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRelayEventsRepository struct {
+	outbox    []entities.Event
+	published []int64
+	err       error
+}
+
+func (m *mockRelayEventsRepository) RelayEvents(ctx context.Context, limit int, publish func(events []entities.Event) (int, error)) (int, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+
+	batch := m.outbox
+	if len(batch) > limit {
+		batch = batch[:limit]
+	}
+
+	n, err := publish(batch)
+	for _, e := range batch[:n] {
+		m.published = append(m.published, e.ID)
+	}
+	m.outbox = m.outbox[n:]
+
+	return n, err
+}
+
+type mockEventPublisher struct {
+	failAt    int64
+	published []int64
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, event entities.Event) error {
+	if event.ID == m.failAt {
+		return assert.AnError
+	}
+
+	m.published = append(m.published, event.ID)
+	return nil
+}
+
+func TestRelayEventsUseCase_RelayEvents(t *testing.T) {
+	outbox := func(n int) []entities.Event {
+		events := make([]entities.Event, 0, n)
+		for i := 1; i <= n; i++ {
+			events = append(events, entities.Event{ID: int64(i), Type: entities.EventTransactionPosted, TransactionID: int64(i)})
+		}
+		return events
+	}
+
+	tests := []struct {
+		name          string
+		repo          *mockRelayEventsRepository
+		publisher     *mockEventPublisher
+		want          int64
+		wantPublished int
+		wantLeft      int
+		wantErr       error
+	}{
+		{
+			name:          "should drain the outbox in batches",
+			repo:          &mockRelayEventsRepository{outbox: outbox(250)},
+			publisher:     &mockEventPublisher{},
+			want:          250,
+			wantPublished: 250,
+		},
+		{
+			name:      "should stop when the outbox is empty",
+			repo:      &mockRelayEventsRepository{},
+			publisher: &mockEventPublisher{},
+		},
+		{
+			name:          "should keep the events after a publishing failure",
+			repo:          &mockRelayEventsRepository{outbox: outbox(150)},
+			publisher:     &mockEventPublisher{failAt: 120},
+			want:          119,
+			wantPublished: 119,
+			wantLeft:      31,
+			wantErr:       assert.AnError,
+		},
+		{
+			name:      "should return repository errors",
+			repo:      &mockRelayEventsRepository{outbox: outbox(1), err: assert.AnError},
+			publisher: &mockEventPublisher{},
+			wantLeft:  1,
+			wantErr:   assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRelayEventsUseCase(tt.repo, tt.publisher).RelayEvents(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, tt.publisher.published, tt.wantPublished)
+			assert.Equal(t, tt.publisher.published, tt.repo.published)
+			assert.Len(t, tt.repo.outbox, tt.wantLeft)
+		})
+	}
+}
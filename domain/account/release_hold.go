@@ -0,0 +1,32 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// ReleaseHoldRepository applies update to a hold and stores the result,
+// failing with domain.ErrHoldNotFound if there is none with the ID. The hold
+// must stay locked while update runs, so it does not race with a capture.
+type ReleaseHoldRepository interface {
+	UpdateHold(ctx context.Context, id int64, update func(*entities.Hold) error) (*entities.Hold, error)
+}
+
+// ReleaseHoldUseCase is a use case for giving up a hold.
+type ReleaseHoldUseCase struct {
+	repo ReleaseHoldRepository
+}
+
+// NewReleaseHoldUseCase creates a new ReleaseHoldUseCase.
+func NewReleaseHoldUseCase(repo ReleaseHoldRepository) *ReleaseHoldUseCase {
+	return &ReleaseHoldUseCase{repo}
+}
+
+// ReleaseHold releases an active hold, making its funds available again.
+func (uc *ReleaseHoldUseCase) ReleaseHold(ctx context.Context, id int64) (*entities.Hold, error) {
+	return uc.repo.UpdateHold(ctx, id, func(h *entities.Hold) error {
+		return h.Release(time.Now())
+	})
+}
@@ -0,0 +1,45 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockReleaseHoldRepository struct {
+	hold entities.Hold
+}
+
+func (m *mockReleaseHoldRepository) UpdateHold(ctx context.Context, id int64, update func(*entities.Hold) error) (*entities.Hold, error) {
+	if id != m.hold.ID {
+		return nil, domain.ErrHoldNotFound
+	}
+
+	h := m.hold
+	if err := update(&h); err != nil {
+		return nil, err
+	}
+	m.hold = h
+	return &h, nil
+}
+
+func TestReleaseHoldUseCase_ReleaseHold(t *testing.T) {
+	repo := &mockReleaseHoldRepository{
+		hold: entities.Hold{ID: 7, Account: "account1", Amount: 100, Status: entities.HoldActive, ExpiresAt: time.Now().Add(time.Hour)},
+	}
+	uc := NewReleaseHoldUseCase(repo)
+
+	got, err := uc.ReleaseHold(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.HoldReleased, got.Status)
+
+	_, err = uc.ReleaseHold(context.Background(), 7)
+	assert.ErrorIs(t, err, domain.ErrHoldNotActive)
+
+	_, err = uc.ReleaseHold(context.Background(), 8)
+	assert.ErrorIs(t, err, domain.ErrHoldNotFound)
+}
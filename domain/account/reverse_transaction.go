@@ -0,0 +1,43 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// ReverseTransactionRepository locks a transaction, lets reverse build the
+// journal entry reversing it from the amount its previous reversals already
+// reversed, and books that entry like JournalRepository does, checking the
+// funds of every account. It fails with domain.ErrTransactionNotFound if there
+// is no transaction with the ID. The transaction must stay locked until the
+// reversal is booked, so concurrent reversals cannot exceed it.
+type ReverseTransactionRepository interface {
+	ReverseTransaction(ctx context.Context, id int64, reverse func(t *entities.Transaction, reversed int64) (*entities.JournalEntry, error)) (int64, error)
+}
+
+// ReverseTransactionUseCase is a use case for undoing a posted transaction.
+type ReverseTransactionUseCase struct {
+	repo ReverseTransactionRepository
+}
+
+// NewReverseTransactionUseCase creates a new ReverseTransactionUseCase.
+func NewReverseTransactionUseCase(repo ReverseTransactionRepository) *ReverseTransactionUseCase {
+	return &ReverseTransactionUseCase{repo}
+}
+
+type ReverseTransactionInput struct {
+	TransactionID int64
+	// Amount is the amount to reverse, all that is left of the transaction
+	// when zero.
+	Amount int64
+}
+
+// ReverseTransaction books a transaction with the opposite entries of the
+// given one, linked to it, and returns its ID. The original transaction is
+// left untouched.
+func (uc *ReverseTransactionUseCase) ReverseTransaction(ctx context.Context, input ReverseTransactionInput) (int64, error) {
+	return uc.repo.ReverseTransaction(ctx, input.TransactionID, func(t *entities.Transaction, reversed int64) (*entities.JournalEntry, error) {
+		return t.Reversal(input.Amount, reversed)
+	})
+}
@@ -0,0 +1,69 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockReverseTransactionRepository struct {
+	transaction entities.Transaction
+	reversals   []entities.JournalEntry
+}
+
+func (m *mockReverseTransactionRepository) ReverseTransaction(ctx context.Context, id int64, reverse func(t *entities.Transaction, reversed int64) (*entities.JournalEntry, error)) (int64, error) {
+	if id != m.transaction.ID {
+		return 0, domain.ErrTransactionNotFound
+	}
+
+	var reversed int64
+	for _, je := range m.reversals {
+		reversed += entities.Transaction{Entries: je.Entries}.Amount()
+	}
+
+	je, err := reverse(&m.transaction, reversed)
+	if err != nil {
+		return 0, err
+	}
+	m.reversals = append(m.reversals, *je)
+	return m.transaction.ID + int64(len(m.reversals)), nil
+}
+
+func TestReverseTransactionUseCase_ReverseTransaction(t *testing.T) {
+	usd := entities.Currency{Code: "USD", Exponent: 2}
+	repo := &mockReverseTransactionRepository{
+		transaction: entities.Transaction{
+			ID:     7,
+			Status: entities.TransactionPosted,
+			Entries: []entities.Entry{
+				{Account: "external", Direction: entities.Credit, Amount: 100, Currency: usd},
+				{Account: "account1", Direction: entities.Debit, Amount: 100, Currency: usd},
+			},
+		},
+	}
+	uc := NewReverseTransactionUseCase(repo)
+
+	// Partial reversal
+	id, err := uc.ReverseTransaction(context.Background(), ReverseTransactionInput{TransactionID: 7, Amount: 40})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), id)
+	assert.Equal(t, int64(7), repo.reversals[0].ReversalOf)
+	assert.Equal(t, entities.Entry{Account: "account1", Direction: entities.Credit, Amount: 40, Currency: usd}, repo.reversals[0].Entries[1])
+
+	_, err = uc.ReverseTransaction(context.Background(), ReverseTransactionInput{TransactionID: 7, Amount: 70})
+	assert.ErrorIs(t, err, domain.ErrReversalExceedsTransaction)
+
+	// The rest of the transaction
+	_, err = uc.ReverseTransaction(context.Background(), ReverseTransactionInput{TransactionID: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), repo.reversals[1].Entries[0].Amount)
+
+	_, err = uc.ReverseTransaction(context.Background(), ReverseTransactionInput{TransactionID: 7})
+	assert.ErrorIs(t, err, domain.ErrTransactionAlreadyReversed)
+
+	_, err = uc.ReverseTransaction(context.Background(), ReverseTransactionInput{TransactionID: 8})
+	assert.ErrorIs(t, err, domain.ErrTransactionNotFound)
+}
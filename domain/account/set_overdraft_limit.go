@@ -0,0 +1,52 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// SetOverdraftLimitRepository changes the overdraft limit of a registered
+// account to change.NewLimit and records the change with the previous limit,
+// failing with domain.ErrAccountNotFound if there is none with the ID. The
+// account must stay locked until both are stored, so postings see either the
+// old or the new limit.
+type SetOverdraftLimitRepository interface {
+	SetOverdraftLimit(ctx context.Context, change entities.OverdraftLimitChange) (*entities.Account, error)
+}
+
+// SetOverdraftLimitUseCase is a use case for changing how far an account may
+// be overdrawn.
+type SetOverdraftLimitUseCase struct {
+	repo SetOverdraftLimitRepository
+}
+
+// NewSetOverdraftLimitUseCase creates a new SetOverdraftLimitUseCase.
+func NewSetOverdraftLimitUseCase(repo SetOverdraftLimitRepository) *SetOverdraftLimitUseCase {
+	return &SetOverdraftLimitUseCase{repo}
+}
+
+type SetOverdraftLimitInput struct {
+	Account string
+
+	// Limit is in minor units, or entities.UnlimitedOverdraft.
+	Limit int64
+
+	// Reason is kept in the audit trail of the change.
+	Reason string
+}
+
+// SetOverdraftLimit changes the overdraft limit of an account and returns it.
+func (uc *SetOverdraftLimitUseCase) SetOverdraftLimit(ctx context.Context, input SetOverdraftLimitInput) (*entities.Account, error) {
+	if err := entities.ValidateOverdraftLimit(input.Limit); err != nil {
+		return nil, err
+	}
+
+	change := entities.OverdraftLimitChange{
+		Account:  input.Account,
+		NewLimit: input.Limit,
+		Reason:   input.Reason,
+	}
+
+	return uc.repo.SetOverdraftLimit(ctx, change)
+}
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSetOverdraftLimitRepository struct {
+	accounts map[string]*entities.Account
+	changes  []entities.OverdraftLimitChange
+}
+
+func (m *mockSetOverdraftLimitRepository) SetOverdraftLimit(ctx context.Context, change entities.OverdraftLimitChange) (*entities.Account, error) {
+	a, ok := m.accounts[change.Account]
+	if !ok {
+		return nil, domain.ErrAccountNotFound
+	}
+
+	change.OldLimit = a.OverdraftLimit
+	if err := a.SetOverdraftLimit(change.NewLimit); err != nil {
+		return nil, err
+	}
+	m.changes = append(m.changes, change)
+
+	return a, nil
+}
+
+func TestSetOverdraftLimitUseCase_SetOverdraftLimit(t *testing.T) {
+	repo := &mockSetOverdraftLimitRepository{
+		accounts: map[string]*entities.Account{
+			"account1": {ID: "account1", Status: entities.AccountOpen},
+		},
+	}
+	uc := NewSetOverdraftLimitUseCase(repo)
+
+	tests := []struct {
+		name      string
+		input     SetOverdraftLimitInput
+		wantLimit int64
+		wantErr   error
+	}{
+		{
+			name:      "should grant a credit line",
+			input:     SetOverdraftLimitInput{Account: "account1", Limit: 500, Reason: "credit line approved"},
+			wantLimit: 500,
+		},
+		{
+			name:      "should allow unlimited overdrafts",
+			input:     SetOverdraftLimitInput{Account: "account1", Limit: entities.UnlimitedOverdraft},
+			wantLimit: entities.UnlimitedOverdraft,
+		},
+		{
+			name:    "should reject negative limits",
+			input:   SetOverdraftLimitInput{Account: "account1", Limit: -500},
+			wantErr: domain.ErrInvalidOverdraftLimit,
+		},
+		{
+			name:    "should fail for unregistered accounts",
+			input:   SetOverdraftLimitInput{Account: "unknown", Limit: 500},
+			wantErr: domain.ErrAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.SetOverdraftLimit(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantLimit, got.OverdraftLimit)
+			}
+		})
+	}
+
+	assert.Equal(t, []entities.OverdraftLimitChange{
+		{Account: "account1", OldLimit: 0, NewLimit: 500, Reason: "credit line approved"},
+		{Account: "account1", OldLimit: 500, NewLimit: entities.UnlimitedOverdraft},
+	}, repo.changes)
+}
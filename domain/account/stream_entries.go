@@ -0,0 +1,123 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// streamBatchSize is the number of entries read at a time by a stream.
+const streamBatchSize = 100
+
+// StreamEntriesRepository reads the entries of an account as they are
+// committed. Entries must be committed in the order of their IDs, so a
+// reader that saw an entry has seen every entry before it.
+type StreamEntriesRepository interface {
+	// Account fails with domain.ErrAccountNotFound unless the account is
+	// registered.
+	Account(ctx context.Context, id string) (*entities.Account, error)
+
+	// LastEntryID returns the ID of the last committed entry, of any account,
+	// or zero if there is none.
+	LastEntryID(ctx context.Context) (int64, error)
+
+	// EntriesAfter returns up to limit entries of the account whose ID is
+	// greater than after, ordered by ID.
+	EntriesAfter(ctx context.Context, account string, after int64, limit int) ([]entities.Entry, error)
+}
+
+// EntryNotifier tells subscribers when new entries of an account may have
+// been committed. Notifications may be spurious and several entries may be
+// reported by one of them, but none may be missed.
+type EntryNotifier interface {
+	// Subscribe returns a channel receiving the notifications of the account,
+	// and a function to call once they are no longer needed.
+	Subscribe(account string) (<-chan struct{}, func())
+}
+
+// StreamEntriesUseCase is a use case for following the activity of an
+// account live.
+type StreamEntriesUseCase struct {
+	repo     StreamEntriesRepository
+	notifier EntryNotifier
+}
+
+// NewStreamEntriesUseCase creates a new StreamEntriesUseCase.
+func NewStreamEntriesUseCase(repo StreamEntriesRepository, notifier EntryNotifier) *StreamEntriesUseCase {
+	return &StreamEntriesUseCase{repo, notifier}
+}
+
+type StreamEntriesInput struct {
+	Account string
+
+	// After resumes the stream past the entry with that ID. The stream starts
+	// with the entries committed from now on when it is zero.
+	After int64
+}
+
+// EntryStream is a live feed of the entries of an account. It must be closed
+// once it is no longer read.
+type EntryStream struct {
+	repo    StreamEntriesRepository
+	account string
+	after   int64
+	notify  <-chan struct{}
+	close   func()
+}
+
+// StreamEntries opens a stream of the entries of the account committed after
+// input.After.
+func (uc *StreamEntriesUseCase) StreamEntries(ctx context.Context, input StreamEntriesInput) (*EntryStream, error) {
+	if _, err := uc.repo.Account(ctx, input.Account); err != nil {
+		return nil, err
+	}
+
+	// Subscribe before reading where the stream starts, so no entry committed
+	// in between is missed.
+	notify, unsubscribe := uc.notifier.Subscribe(input.Account)
+
+	after := input.After
+	if after <= 0 {
+		var err error
+		after, err = uc.repo.LastEntryID(ctx)
+		if err != nil {
+			unsubscribe()
+			return nil, err
+		}
+	}
+
+	return &EntryStream{
+		repo:    uc.repo,
+		account: input.Account,
+		after:   after,
+		notify:  notify,
+		close:   unsubscribe,
+	}, nil
+}
+
+// Next returns the next entries of the stream, ordered by ID, waiting until
+// there are some or ctx is done.
+func (s *EntryStream) Next(ctx context.Context) ([]entities.Entry, error) {
+	for {
+		entries, err := s.repo.EntriesAfter(ctx, s.account, s.after, streamBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entries) > 0 {
+			s.after = entries[len(entries)-1].ID
+			return entries, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close stops the notifications of the stream.
+func (s *EntryStream) Close() {
+	s.close()
+}
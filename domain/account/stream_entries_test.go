@@ -0,0 +1,121 @@
+package account
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStreamEntriesRepository struct {
+	mu      sync.Mutex
+	entries []entities.Entry
+}
+
+func (m *mockStreamEntriesRepository) Account(ctx context.Context, id string) (*entities.Account, error) {
+	if id != "account1" {
+		return nil, domain.ErrAccountNotFound
+	}
+	return &entities.Account{ID: id}, nil
+}
+
+func (m *mockStreamEntriesRepository) LastEntryID(ctx context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.entries) == 0 {
+		return 0, nil
+	}
+	return m.entries[len(m.entries)-1].ID, nil
+}
+
+func (m *mockStreamEntriesRepository) EntriesAfter(ctx context.Context, account string, after int64, limit int) ([]entities.Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var entries []entities.Entry
+	for _, e := range m.entries {
+		if e.Account == account && e.ID > after && len(entries) < limit {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (m *mockStreamEntriesRepository) commit(e entities.Entry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e.ID = int64(len(m.entries) + 1)
+	m.entries = append(m.entries, e)
+}
+
+type mockEntryNotifier struct {
+	notify       chan struct{}
+	unsubscribed bool
+}
+
+func (m *mockEntryNotifier) Subscribe(account string) (<-chan struct{}, func()) {
+	return m.notify, func() { m.unsubscribed = true }
+}
+
+func TestStreamEntriesUseCase_StreamEntries(t *testing.T) {
+	repo := &mockStreamEntriesRepository{}
+	repo.commit(entities.Entry{Account: "account1", Amount: 10})
+	repo.commit(entities.Entry{Account: "account2", Amount: 20})
+	repo.commit(entities.Entry{Account: "account1", Amount: 30})
+
+	t.Run("should reject unknown accounts", func(t *testing.T) {
+		uc := NewStreamEntriesUseCase(repo, &mockEntryNotifier{})
+		_, err := uc.StreamEntries(context.Background(), StreamEntriesInput{Account: "account3"})
+		assert.ErrorIs(t, err, domain.ErrAccountNotFound)
+	})
+
+	t.Run("should resume after the given entry", func(t *testing.T) {
+		uc := NewStreamEntriesUseCase(repo, &mockEntryNotifier{})
+		stream, err := uc.StreamEntries(context.Background(), StreamEntriesInput{Account: "account1", After: 1})
+		assert.NoError(t, err)
+		defer stream.Close()
+
+		entries, err := stream.Next(context.Background())
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, int64(3), entries[0].ID)
+		}
+	})
+
+	t.Run("should wait for new entries", func(t *testing.T) {
+		notifier := &mockEntryNotifier{notify: make(chan struct{}, 1)}
+		uc := NewStreamEntriesUseCase(repo, notifier)
+		stream, err := uc.StreamEntries(context.Background(), StreamEntriesInput{Account: "account1"})
+		assert.NoError(t, err)
+
+		// Spurious notifications are ignored
+		notifier.notify <- struct{}{}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			repo.commit(entities.Entry{Account: "account2", Amount: 40})
+			repo.commit(entities.Entry{Account: "account1", Amount: 50})
+			notifier.notify <- struct{}{}
+		}()
+
+		entries, err := stream.Next(context.Background())
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, int64(5), entries[0].ID)
+			assert.Equal(t, int64(50), entries[0].Amount)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = stream.Next(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		stream.Close()
+		assert.True(t, notifier.unsubscribed)
+	})
+}
@@ -0,0 +1,26 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+type TransactionRepository interface {
+	Transaction(ctx context.Context, id int64) (*entities.Transaction, error)
+}
+
+// TransactionUseCase is a use case for retrieving a transaction with its entries.
+type TransactionUseCase struct {
+	repo TransactionRepository
+}
+
+// NewTransactionUseCase creates a new TransactionUseCase.
+func NewTransactionUseCase(repo TransactionRepository) *TransactionUseCase {
+	return &TransactionUseCase{repo}
+}
+
+// Transaction returns the transaction with the given ID.
+func (uc *TransactionUseCase) Transaction(ctx context.Context, id int64) (*entities.Transaction, error) {
+	return uc.repo.Transaction(ctx, id)
+}
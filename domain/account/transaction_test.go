@@ -0,0 +1,42 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTransactionRepository struct{}
+
+func (m *mockTransactionRepository) Transaction(ctx context.Context, id int64) (*entities.Transaction, error) {
+	if id != 1 {
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	return &entities.Transaction{
+		ID: 1,
+		Entries: []entities.Entry{
+			{ID: 1, TransactionID: 1, Account: "account1", Direction: entities.Credit, Amount: 100},
+			{ID: 2, TransactionID: 1, Account: "account2", Direction: entities.Debit, Amount: 100},
+		},
+	}, nil
+}
+
+func TestTransactionUseCase_Transaction(t *testing.T) {
+	useCase := NewTransactionUseCase(&mockTransactionRepository{})
+	ctx := context.Background()
+
+	// Test case 1: Existing transaction
+	transaction, err := useCase.Transaction(ctx, 1)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, int64(1), transaction.ID, "unexpected transaction ID")
+	assert.Len(t, transaction.Entries, 2, "unexpected number of entries")
+
+	// Test case 2: Unknown transaction
+	transaction, err = useCase.Transaction(ctx, 2)
+	assert.ErrorIs(t, err, domain.ErrTransactionNotFound, "expected not found error")
+	assert.Nil(t, transaction, "expected no transaction")
+}
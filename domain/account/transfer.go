@@ -7,57 +7,72 @@ import (
 	"github.com/julioc98/ledger/domain/entities"
 )
 
+// TransferRepository books a double entry, failing with
+// domain.ErrInsufficientFunds if it would take either account beyond its
+// overdraft limit, and with domain.ErrAccountNotFound, domain.ErrAccountFrozen
+// or domain.ErrAccountClosed unless both accounts are registered and open. The
+// checks and the write must be atomic.
 type TransferRepository interface {
-	Transfer(ctx context.Context, entries entities.DoubleEntry) error
-}
-
-type AccountRepository interface {
-	Balance(ctx context.Context, account string) (int64, error)
+	TransferWithFundsCheck(ctx context.Context, entries entities.DoubleEntry) (int64, error)
 }
 
 // TransferUseCase is a use case for Transfering money into an account.
 type TransferUseCase struct {
-	repo  TransferRepository
-	aRepo AccountRepository
+	repo TransferRepository
 }
 
 // NewTransferUseCase creates a new TransferUseCase.
-func NewTransferUseCase(repo TransferRepository, aRepo AccountRepository) *TransferUseCase {
-	return &TransferUseCase{repo, aRepo}
+func NewTransferUseCase(repo TransferRepository) *TransferUseCase {
+	return &TransferUseCase{repo}
 }
 
 type TransferInput struct {
 	FromAccount string
 	ToAccount   string
 	Amount      int64
-}
+	Currency    string
 
-// Transfer Transfers money into an account.
-func (uc *TransferUseCase) Transfer(ctx context.Context, input TransferInput) error {
+	// IdempotencyKey, when set, makes retrying the transfer safe.
+	IdempotencyKey string
+}
 
-	credit, err := entities.NewEntry(0, input.FromAccount, entities.Credit, input.Amount, nil)
+// Transfer Transfers money into an account and returns the transaction ID.
+func (uc *TransferUseCase) Transfer(ctx context.Context, input TransferInput) (int64, error) {
+	de, err := transferEntry(input)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	debit, err := entities.NewEntry(0, input.ToAccount, entities.Debit, input.Amount, nil)
+	return uc.repo.TransferWithFundsCheck(ctx, *de)
+}
+
+// transferEntry validates the transfer and returns the double entry booking
+// it.
+func transferEntry(input TransferInput) (*entities.DoubleEntry, error) {
+	currency, err := entities.NewCurrency(input.Currency)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	de, err := entities.NewDoubleEntry(*debit, *credit)
+	credit, err := entities.NewEntry(0, input.FromAccount, entities.Credit, input.Amount, currency, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	balance, err := uc.aRepo.Balance(ctx, input.FromAccount)
+	debit, err := entities.NewEntry(0, input.ToAccount, entities.Debit, input.Amount, currency, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if input.FromAccount == input.ToAccount {
+		return nil, domain.ErrSelfTransfer
 	}
 
-	if balance < input.Amount {
-		return domain.ErrInsufficientFunds
+	de, err := entities.NewDoubleEntry(*debit, *credit)
+	if err != nil {
+		return nil, err
 	}
+	de.IdempotencyKey = input.IdempotencyKey
 
-	return uc.repo.Transfer(ctx, *de)
+	return de, nil
 }
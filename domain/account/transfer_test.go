@@ -11,28 +11,19 @@ import (
 )
 
 type mockTransferRepo struct {
-	transferFunc func(ctx context.Context, entries entities.DoubleEntry) error
+	transferFunc func(ctx context.Context, entries entities.DoubleEntry) (int64, error)
 }
 
-func (m *mockTransferRepo) Transfer(ctx context.Context, entries entities.DoubleEntry) error {
+func (m *mockTransferRepo) TransferWithFundsCheck(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
 	return m.transferFunc(ctx, entries)
 }
 
-type mockAccountRepo struct {
-	balanceFunc func(ctx context.Context, account string) (int64, error)
-}
-
-func (m *mockAccountRepo) Balance(ctx context.Context, account string) (int64, error) {
-	return m.balanceFunc(ctx, account)
-}
-
 func TestTransferUseCase_Transfer(t *testing.T) {
-	// Create mock repositories
+	// Create mock repository
 	mockTransferRepo := &mockTransferRepo{}
-	mockAccountRepo := &mockAccountRepo{}
 
-	// Create the TransferUseCase with the mock repositories
-	transferUseCase := NewTransferUseCase(mockTransferRepo, mockAccountRepo)
+	// Create the TransferUseCase with the mock repository
+	transferUseCase := NewTransferUseCase(mockTransferRepo)
 
 	// Define test cases
 	testCases := []struct {
@@ -62,30 +53,77 @@ func TestTransferUseCase_Transfer(t *testing.T) {
 			expectedError:  domain.ErrInsufficientFunds,
 			transferCalled: false,
 		},
+		{
+			name:           "Frozen destination",
+			fromAccount:    "account1",
+			toAccount:      "frozen-account",
+			amount:         100,
+			balance:        200,
+			expectedError:  domain.ErrAccountFrozen,
+			transferCalled: false,
+		},
+		{
+			name:           "Self transfer",
+			fromAccount:    "account1",
+			toAccount:      "account1",
+			amount:         100,
+			balance:        200,
+			expectedError:  domain.ErrSelfTransfer,
+			transferCalled: false,
+		},
+		{
+			name:           "Empty destination",
+			fromAccount:    "account1",
+			toAccount:      "",
+			amount:         100,
+			balance:        200,
+			expectedError:  domain.ErrEmptyAccount,
+			transferCalled: false,
+		},
+		{
+			name:           "Invalid destination",
+			fromAccount:    "account1",
+			toAccount:      "account 2",
+			amount:         100,
+			balance:        200,
+			expectedError:  domain.ErrInvalidAccount,
+			transferCalled: false,
+		},
+		{
+			name:           "Negative amount",
+			fromAccount:    "account1",
+			toAccount:      "account2",
+			amount:         -100,
+			balance:        200,
+			expectedError:  domain.ErrInvalidAmount,
+			transferCalled: false,
+		},
 	}
 
 	// Run test cases
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// Set up the mock AccountRepository's Balance function
-			mockAccountRepo.balanceFunc = func(ctx context.Context, account string) (int64, error) {
-				if account == tc.fromAccount {
-					return tc.balance, nil
+			// Set up the mock TransferRepository, which owns the funds check
+			mockTransferRepo.transferFunc = func(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+				if entries.Credit.Account != tc.fromAccount {
+					return 0, errors.New("unexpected account")
+				}
+				if entries.Debit.Account == "frozen-account" {
+					return 0, domain.ErrAccountFrozen
+				}
+				if tc.balance < entries.Credit.Amount {
+					return 0, domain.ErrInsufficientFunds
 				}
-				return 0, errors.New("unexpected account")
-			}
-
-			// Set up the mock TransferRepository's Transfer function
-			mockTransferRepo.transferFunc = func(ctx context.Context, entries entities.DoubleEntry) error {
 				tc.transferCalled = true
-				return nil
+				return 1, nil
 			}
 
 			// Call the Transfer method of the TransferUseCase
-			err := transferUseCase.Transfer(context.Background(), TransferInput{
+			_, err := transferUseCase.Transfer(context.Background(), TransferInput{
 				FromAccount: tc.fromAccount,
 				ToAccount:   tc.toAccount,
 				Amount:      tc.amount,
+				Currency:    "USD",
 			})
 
 			// Check if the error matches the expected error
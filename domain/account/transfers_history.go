@@ -2,31 +2,113 @@ package account
 
 import (
 	"context"
+	"time"
 
+	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/entities"
 )
 
+const (
+	// DefaultHistoryLimit is the page size used when none is requested.
+	DefaultHistoryLimit = 50
+	// MaxHistoryLimit is the largest page size that can be requested.
+	MaxHistoryLimit = 500
+)
+
+// TransfersHistoryRepository returns up to query.Limit entries matching the
+// query, newest first.
 type TransfersHistoryRepository interface {
-	TransfersHistory(ctx context.Context, account string) ([]entities.Entry, error)
+	TransfersHistory(ctx context.Context, query entities.HistoryQuery) ([]entities.Entry, error)
 }
 
 type TransfersHistoryUseCase struct {
 	repo TransfersHistoryRepository
 }
 
+type TransfersHistoryInput struct {
+	Account string
+
+	// From and To bound the creation time to [From, To) when set.
+	From *time.Time
+	To   *time.Time
+
+	// Direction keeps only debits or only credits when set.
+	Direction entities.Direction
+
+	// MinAmount and MaxAmount bound the amount, inclusively, when non-zero.
+	MinAmount int64
+	MaxAmount int64
+
+	// Cursor is the NextCursor of the previous page, empty for the first one.
+	Cursor string
+
+	// Limit is the page size, DefaultHistoryLimit when zero.
+	Limit int
+}
+
 type TransfersHistoryOutput struct {
 	Entries []entities.Entry `json:"entries"`
+
+	// NextCursor fetches the next page, empty on the last one.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 func NewTransfersHistoryUseCase(repo TransfersHistoryRepository) *TransfersHistoryUseCase {
 	return &TransfersHistoryUseCase{repo}
 }
 
-func (uc *TransfersHistoryUseCase) TransfersHistory(ctx context.Context, account string) (*TransfersHistoryOutput, error) {
-	entries, err := uc.repo.TransfersHistory(ctx, account)
+// TransfersHistory returns a page of the entries of an account, newest first.
+func (uc *TransfersHistoryUseCase) TransfersHistory(ctx context.Context, input TransfersHistoryInput) (*TransfersHistoryOutput, error) {
+	query := entities.HistoryQuery{
+		Account:   input.Account,
+		From:      input.From,
+		To:        input.To,
+		Direction: input.Direction,
+		MinAmount: input.MinAmount,
+		MaxAmount: input.MaxAmount,
+		Limit:     input.Limit,
+	}
+
+	if query.Direction != "" && query.Direction != entities.Debit && query.Direction != entities.Credit {
+		return nil, domain.ErrIvalidDirection
+	}
+
+	if query.MinAmount < 0 || query.MaxAmount < 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	if query.Limit == 0 {
+		query.Limit = DefaultHistoryLimit
+	}
+
+	if query.Limit < 0 || query.Limit > MaxHistoryLimit {
+		return nil, domain.ErrInvalidPageLimit
+	}
+
+	if input.Cursor != "" {
+		cursor, err := entities.ParseCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query.After = cursor
+	}
+
+	// Ask for one more entry than requested to know whether there is a next page.
+	query.Limit++
+	entries, err := uc.repo.TransfersHistory(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &TransfersHistoryOutput{entries}, nil
+	output := &TransfersHistoryOutput{Entries: entries}
+	if len(entries) == query.Limit {
+		output.Entries = entries[:len(entries)-1]
+		output.NextCursor = entities.NewCursor(output.Entries[len(output.Entries)-1]).String()
+	}
+
+	if output.Entries == nil {
+		output.Entries = []entities.Entry{}
+	}
+
+	return output, nil
 }
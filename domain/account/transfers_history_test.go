@@ -3,43 +3,116 @@ package account
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/entities"
 	"github.com/stretchr/testify/assert"
 )
 
-type mockTransfersHistoryRepository struct{}
+type mockTransfersHistoryRepository struct {
+	entries []entities.Entry
+	query   entities.HistoryQuery
+}
 
-func (m *mockTransfersHistoryRepository) TransfersHistory(ctx context.Context, account string) ([]entities.Entry, error) {
-	// Mock implementation of TransfersHistory repository method
-	// Return some dummy data for testing
-	entries := []entities.Entry{
-		{ID: 1, Amount: 100},
-		{ID: 2, Amount: 200},
-		{ID: 3, Amount: 300},
+func (m *mockTransfersHistoryRepository) TransfersHistory(ctx context.Context, query entities.HistoryQuery) ([]entities.Entry, error) {
+	m.query = query
+
+	// Return at most query.Limit of the stored entries, like the database does
+	if len(m.entries) > query.Limit {
+		return m.entries[:query.Limit], nil
 	}
-	return entries, nil
+	return m.entries, nil
 }
 
 func TestTransfersHistoryUseCase_TransfersHistory(t *testing.T) {
-	repo := &mockTransfersHistoryRepository{}
-	useCase := NewTransfersHistoryUseCase(repo)
-
-	ctx := context.Background()
-	account := "example-account"
-
-	output, err := useCase.TransfersHistory(ctx, account)
-	assert.NoError(t, err, "unexpected error")
+	timeMock := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	entries := []entities.Entry{
+		{ID: 3, Amount: 300, CreatedAt: &timeMock},
+		{ID: 2, Amount: 200, CreatedAt: &timeMock},
+		{ID: 1, Amount: 100, CreatedAt: &timeMock},
+	}
+	cursor := entities.NewCursor(entries[1]).String()
 
-	expectedEntries := []entities.Entry{
-		{ID: 1, Amount: 100},
-		{ID: 2, Amount: 200},
-		{ID: 3, Amount: 300},
+	tests := []struct {
+		name           string
+		input          TransfersHistoryInput
+		wantEntries    []entities.Entry
+		wantNextCursor string
+		wantQuery      entities.HistoryQuery
+		wantErr        error
+	}{
+		{
+			name:        "should return all entries in a single page",
+			input:       TransfersHistoryInput{Account: "example-account"},
+			wantEntries: entries,
+			wantQuery:   entities.HistoryQuery{Account: "example-account", Limit: DefaultHistoryLimit + 1},
+		},
+		{
+			name:           "should return a cursor when there are more entries",
+			input:          TransfersHistoryInput{Account: "example-account", Limit: 2},
+			wantEntries:    entries[:2],
+			wantNextCursor: cursor,
+			wantQuery:      entities.HistoryQuery{Account: "example-account", Limit: 3},
+		},
+		{
+			name: "should pass the filters and the cursor to the repository",
+			input: TransfersHistoryInput{
+				Account:   "example-account",
+				From:      &timeMock,
+				Direction: entities.Debit,
+				MinAmount: 100,
+				MaxAmount: 500,
+				Cursor:    cursor,
+				Limit:     10,
+			},
+			wantEntries: entries,
+			wantQuery: entities.HistoryQuery{
+				Account:   "example-account",
+				From:      &timeMock,
+				Direction: entities.Debit,
+				MinAmount: 100,
+				MaxAmount: 500,
+				After:     &entities.Cursor{CreatedAt: timeMock, ID: 2},
+				Limit:     11,
+			},
+		},
+		{
+			name:    "should reject invalid cursors",
+			input:   TransfersHistoryInput{Account: "example-account", Cursor: "%%%"},
+			wantErr: domain.ErrInvalidCursor,
+		},
+		{
+			name:    "should reject limits above the maximum",
+			input:   TransfersHistoryInput{Account: "example-account", Limit: MaxHistoryLimit + 1},
+			wantErr: domain.ErrInvalidPageLimit,
+		},
+		{
+			name:    "should reject invalid directions",
+			input:   TransfersHistoryInput{Account: "example-account", Direction: "sideways"},
+			wantErr: domain.ErrIvalidDirection,
+		},
+		{
+			name:    "should reject negative amounts",
+			input:   TransfersHistoryInput{Account: "example-account", MinAmount: -1},
+			wantErr: domain.ErrInvalidAmount,
+		},
 	}
 
-	assert.Len(t, output.Entries, len(expectedEntries), "unexpected number of entries")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockTransfersHistoryRepository{entries: entries}
+			useCase := NewTransfersHistoryUseCase(repo)
+
+			output, err := useCase.TransfersHistory(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr, "unexpected error")
+			if tt.wantErr != nil {
+				return
+			}
 
-	for i, entry := range output.Entries {
-		assert.Equal(t, expectedEntries[i], entry, "unexpected entry")
+			assert.Equal(t, tt.wantEntries, output.Entries, "unexpected entries")
+			assert.Equal(t, tt.wantNextCursor, output.NextCursor, "unexpected next cursor")
+			assert.Equal(t, tt.wantQuery, repo.query, "unexpected query")
+		})
 	}
 }
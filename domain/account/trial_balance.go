@@ -0,0 +1,38 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// TrialBalanceRepository returns the debit and credit totals of every account
+// in each currency from the entries posted up to asOf, or from all posted
+// entries when asOf is nil, ordered by account and currency.
+type TrialBalanceRepository interface {
+	TrialBalance(ctx context.Context, asOf *time.Time) ([]entities.TrialBalanceLine, error)
+}
+
+// TrialBalanceUseCase is a use case for reporting the trial balance.
+type TrialBalanceUseCase struct {
+	repo TrialBalanceRepository
+}
+
+// NewTrialBalanceUseCase creates a new TrialBalanceUseCase.
+func NewTrialBalanceUseCase(repo TrialBalanceRepository) *TrialBalanceUseCase {
+	return &TrialBalanceUseCase{repo}
+}
+
+// TrialBalance returns the debit and credit totals of every account at asOf,
+// or now when asOf is nil, with the grand totals of each currency, which must
+// be equal.
+func (uc *TrialBalanceUseCase) TrialBalance(ctx context.Context, asOf *time.Time) (*entities.TrialBalance, error) {
+	lines, err := uc.repo.TrialBalance(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	tb := entities.NewTrialBalance(lines)
+	return &tb, nil
+}
@@ -0,0 +1,67 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTrialBalanceRepository struct {
+	lines []entities.TrialBalanceLine
+	err   error
+	asOf  *time.Time
+}
+
+func (m *mockTrialBalanceRepository) TrialBalance(ctx context.Context, asOf *time.Time) ([]entities.TrialBalanceLine, error) {
+	m.asOf = asOf
+	return m.lines, m.err
+}
+
+func TestTrialBalanceUseCase_TrialBalance(t *testing.T) {
+	usd := entities.Currency{Code: "USD", Exponent: 2}
+	asOf := time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC)
+	lines := []entities.TrialBalanceLine{
+		{Account: "account1", Currency: usd, Debits: 100, Credits: 40},
+		{Account: "external", Currency: usd, Credits: 60},
+	}
+
+	tests := []struct {
+		name    string
+		repo    *mockTrialBalanceRepository
+		asOf    *time.Time
+		want    *entities.TrialBalance
+		wantErr error
+	}{
+		{
+			name: "should total the lines",
+			repo: &mockTrialBalanceRepository{lines: lines},
+			want: &entities.TrialBalance{
+				Lines:  lines,
+				Totals: []entities.TrialBalanceTotal{{Currency: usd, Debits: 100, Credits: 100}},
+			},
+		},
+		{
+			name: "should pass the point in time",
+			repo: &mockTrialBalanceRepository{},
+			asOf: &asOf,
+			want: &entities.TrialBalance{},
+		},
+		{
+			name:    "should return repository errors",
+			repo:    &mockTrialBalanceRepository{err: assert.AnError},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTrialBalanceUseCase(tt.repo).TrialBalance(context.Background(), tt.asOf)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.asOf, tt.repo.asOf)
+		})
+	}
+}
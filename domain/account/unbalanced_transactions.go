@@ -0,0 +1,31 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// UnbalancedTransactionsRepository returns, per currency, the transactions
+// whose debits do not equal their credits, whatever their status, ordered by
+// transaction and currency.
+type UnbalancedTransactionsRepository interface {
+	UnbalancedTransactions(ctx context.Context) ([]entities.UnbalancedTransaction, error)
+}
+
+// UnbalancedTransactionsUseCase is a use case for checking the double-entry
+// invariant of every transaction.
+type UnbalancedTransactionsUseCase struct {
+	repo UnbalancedTransactionsRepository
+}
+
+// NewUnbalancedTransactionsUseCase creates a new UnbalancedTransactionsUseCase.
+func NewUnbalancedTransactionsUseCase(repo UnbalancedTransactionsRepository) *UnbalancedTransactionsUseCase {
+	return &UnbalancedTransactionsUseCase{repo}
+}
+
+// UnbalancedTransactions returns the transactions whose legs do not net to
+// zero in some currency. The ledger upholds the invariant when there are none.
+func (uc *UnbalancedTransactionsUseCase) UnbalancedTransactions(ctx context.Context) ([]entities.UnbalancedTransaction, error) {
+	return uc.repo.UnbalancedTransactions(ctx)
+}
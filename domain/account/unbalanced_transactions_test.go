@@ -0,0 +1,54 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUnbalancedTransactionsRepository struct {
+	unbalanced []entities.UnbalancedTransaction
+	err        error
+}
+
+func (m *mockUnbalancedTransactionsRepository) UnbalancedTransactions(ctx context.Context) ([]entities.UnbalancedTransaction, error) {
+	return m.unbalanced, m.err
+}
+
+func TestUnbalancedTransactionsUseCase_UnbalancedTransactions(t *testing.T) {
+	unbalanced := []entities.UnbalancedTransaction{
+		{TransactionID: 7, Currency: entities.Currency{Code: "USD", Exponent: 2}, Debits: 100, Credits: 90},
+	}
+
+	tests := []struct {
+		name    string
+		repo    *mockUnbalancedTransactionsRepository
+		want    []entities.UnbalancedTransaction
+		wantErr error
+	}{
+		{
+			name: "should report the unbalanced transactions",
+			repo: &mockUnbalancedTransactionsRepository{unbalanced: unbalanced},
+			want: unbalanced,
+		},
+		{
+			name: "should report none",
+			repo: &mockUnbalancedTransactionsRepository{},
+		},
+		{
+			name:    "should return repository errors",
+			repo:    &mockUnbalancedTransactionsRepository{err: assert.AnError},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewUnbalancedTransactionsUseCase(tt.repo).UnbalancedTransactions(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// UpdateAccountRepository applies update to a registered account and stores
+// the result, failing with domain.ErrAccountNotFound if there is none with
+// the ID. The account must stay locked while update runs, so changes do not
+// race with each other or with postings.
+type UpdateAccountRepository interface {
+	UpdateAccount(ctx context.Context, id string, update func(*entities.Account) error) (*entities.Account, error)
+}
+
+// UpdateAccountUseCase is a use case for changing the owner or the status of
+// a registered account.
+type UpdateAccountUseCase struct {
+	repo UpdateAccountRepository
+}
+
+// NewUpdateAccountUseCase creates a new UpdateAccountUseCase.
+func NewUpdateAccountUseCase(repo UpdateAccountRepository) *UpdateAccountUseCase {
+	return &UpdateAccountUseCase{repo}
+}
+
+type UpdateAccountInput struct {
+	ID string
+
+	// Owner and Status are left unchanged when nil.
+	Owner  *string
+	Status *string
+}
+
+// UpdateAccount changes the owner or the status of an account, e.g. to
+// freeze, unfreeze or close it.
+func (uc *UpdateAccountUseCase) UpdateAccount(ctx context.Context, input UpdateAccountInput) (*entities.Account, error) {
+	var status entities.AccountStatus
+	if input.Status != nil {
+		var err error
+		status, err = entities.NewAccountStatus(*input.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.repo.UpdateAccount(ctx, input.ID, func(a *entities.Account) error {
+		if input.Owner != nil {
+			a.Owner = *input.Owner
+		}
+
+		if input.Status != nil {
+			return a.SetStatus(status)
+		}
+
+		return nil
+	})
+}
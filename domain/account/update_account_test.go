@@ -0,0 +1,82 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockUpdateAccountRepository struct {
+	account entities.Account
+}
+
+func (m *mockUpdateAccountRepository) UpdateAccount(ctx context.Context, id string, update func(*entities.Account) error) (*entities.Account, error) {
+	if id != m.account.ID {
+		return nil, domain.ErrAccountNotFound
+	}
+
+	a := m.account
+	if err := update(&a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func TestUpdateAccountUseCase_UpdateAccount(t *testing.T) {
+	owner := "customer-2"
+	frozen := "frozen"
+	open := "open"
+	unknown := "deleted"
+
+	tests := []struct {
+		name    string
+		account entities.Account
+		input   UpdateAccountInput
+		want    *entities.Account
+		wantErr error
+	}{
+		{
+			name:    "should freeze an open account",
+			account: entities.Account{ID: "account1", Owner: "customer-1", Status: entities.AccountOpen},
+			input:   UpdateAccountInput{ID: "account1", Status: &frozen},
+			want:    &entities.Account{ID: "account1", Owner: "customer-1", Status: entities.AccountFrozen},
+		},
+		{
+			name:    "should change the owner only",
+			account: entities.Account{ID: "account1", Owner: "customer-1", Status: entities.AccountFrozen},
+			input:   UpdateAccountInput{ID: "account1", Owner: &owner},
+			want:    &entities.Account{ID: "account1", Owner: "customer-2", Status: entities.AccountFrozen},
+		},
+		{
+			name:    "should not reopen a closed account",
+			account: entities.Account{ID: "account1", Status: entities.AccountClosed},
+			input:   UpdateAccountInput{ID: "account1", Status: &open},
+			wantErr: domain.ErrInvalidStatusTransition,
+		},
+		{
+			name:    "should reject unknown statuses",
+			account: entities.Account{ID: "account1", Status: entities.AccountOpen},
+			input:   UpdateAccountInput{ID: "account1", Status: &unknown},
+			wantErr: domain.ErrInvalidAccountStatus,
+		},
+		{
+			name:    "should reject unknown accounts",
+			account: entities.Account{ID: "account1", Status: entities.AccountOpen},
+			input:   UpdateAccountInput{ID: "account2", Status: &frozen},
+			wantErr: domain.ErrAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewUpdateAccountUseCase(&mockUpdateAccountRepository{account: tt.account})
+
+			got, err := uc.UpdateAccount(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
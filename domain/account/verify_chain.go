@@ -0,0 +1,52 @@
+package account
+
+import (
+	"context"
+	"errors"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// VerifyChainRepository walks the ledger's hash chain, calling visit with
+// every entry and its stored hash in the order they were chained, and stops
+// at the first error visit returns.
+type VerifyChainRepository interface {
+	WalkChain(ctx context.Context, visit func(link entities.ChainLink) error) error
+}
+
+// VerifyChainUseCase is a use case for proving the entries were not altered.
+type VerifyChainUseCase struct {
+	repo VerifyChainRepository
+}
+
+// NewVerifyChainUseCase creates a new VerifyChainUseCase.
+func NewVerifyChainUseCase(repo VerifyChainRepository) *VerifyChainUseCase {
+	return &VerifyChainUseCase{repo}
+}
+
+// errChainBroken stops the walk at the first broken link.
+var errChainBroken = errors.New("hash chain broken")
+
+// VerifyChain recomputes the hash of every entry from its content and the
+// previous entry's hash, and reports the first one that does not match the
+// stored hash.
+func (uc *VerifyChainUseCase) VerifyChain(ctx context.Context) (*entities.ChainVerification, error) {
+	var v entities.ChainVerification
+	var prev string
+	err := uc.repo.WalkChain(ctx, func(link entities.ChainLink) error {
+		expected := link.Entry.Hash(prev)
+		if link.Hash != expected {
+			v.Break = &entities.ChainBreak{EntryID: link.Entry.ID, Expected: expected, Stored: link.Hash}
+			return errChainBroken
+		}
+
+		v.Verified++
+		prev = link.Hash
+		return nil
+	})
+	if err != nil && !errors.Is(err, errChainBroken) {
+		return nil, err
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,105 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockVerifyChainRepository struct {
+	links   []entities.ChainLink
+	err     error
+	visited int
+}
+
+func (m *mockVerifyChainRepository) WalkChain(ctx context.Context, visit func(link entities.ChainLink) error) error {
+	for _, link := range m.links {
+		m.visited++
+		if err := visit(link); err != nil {
+			return err
+		}
+	}
+
+	return m.err
+}
+
+func TestVerifyChainUseCase_VerifyChain(t *testing.T) {
+	usd := entities.Currency{Code: "USD", Exponent: 2}
+	entries := []entities.Entry{
+		{ID: 1, TransactionID: 1, Account: "external", Direction: entities.Credit, Amount: 100, Currency: usd},
+		{ID: 2, TransactionID: 1, Account: "account1", Direction: entities.Debit, Amount: 100, Currency: usd},
+		{ID: 3, TransactionID: 2, Account: "account1", Direction: entities.Credit, Amount: 40, Currency: usd},
+	}
+	chain := func() []entities.ChainLink {
+		var links []entities.ChainLink
+		var prev string
+		for _, e := range entries {
+			prev = e.Hash(prev)
+			links = append(links, entities.ChainLink{Entry: e, Hash: prev})
+		}
+		return links
+	}
+
+	tampered := chain()
+	tampered[1].Entry.Amount = 1000
+
+	missing := chain()
+	missing = append(missing[:1], missing[2:]...)
+
+	tests := []struct {
+		name        string
+		repo        *mockVerifyChainRepository
+		want        *entities.ChainVerification
+		wantVisited int
+		wantErr     error
+	}{
+		{
+			name:        "should verify an intact chain",
+			repo:        &mockVerifyChainRepository{links: chain()},
+			want:        &entities.ChainVerification{Verified: 3},
+			wantVisited: 3,
+		},
+		{
+			name: "should verify an empty ledger",
+			repo: &mockVerifyChainRepository{},
+			want: &entities.ChainVerification{},
+		},
+		{
+			name: "should report an altered entry and stop",
+			repo: &mockVerifyChainRepository{links: tampered},
+			want: &entities.ChainVerification{Verified: 1, Break: &entities.ChainBreak{
+				EntryID:  2,
+				Expected: tampered[1].Entry.Hash(tampered[0].Hash),
+				Stored:   tampered[1].Hash,
+			}},
+			wantVisited: 2,
+		},
+		{
+			name: "should report the entry after a deleted one",
+			repo: &mockVerifyChainRepository{links: missing},
+			want: &entities.ChainVerification{Verified: 1, Break: &entities.ChainBreak{
+				EntryID:  3,
+				Expected: missing[1].Entry.Hash(missing[0].Hash),
+				Stored:   missing[1].Hash,
+			}},
+			wantVisited: 2,
+		},
+		{
+			name:        "should return repository errors",
+			repo:        &mockVerifyChainRepository{links: chain(), err: assert.AnError},
+			wantVisited: 3,
+			wantErr:     assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewVerifyChainUseCase(tt.repo).VerifyChain(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantVisited, tt.repo.visited)
+		})
+	}
+}
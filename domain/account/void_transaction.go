@@ -0,0 +1,33 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// VoidTransactionRepository applies update to a transaction and stores the
+// result, failing with domain.ErrTransactionNotFound if there is none with the
+// ID. The transaction must stay locked while update runs, so it does not race
+// with posting it.
+type VoidTransactionRepository interface {
+	UpdateTransaction(ctx context.Context, id int64, update func(*entities.Transaction) error) (*entities.Transaction, error)
+}
+
+// VoidTransactionUseCase is a use case for cancelling a pending transfer.
+type VoidTransactionUseCase struct {
+	repo VoidTransactionRepository
+}
+
+// NewVoidTransactionUseCase creates a new VoidTransactionUseCase.
+func NewVoidTransactionUseCase(repo VoidTransactionRepository) *VoidTransactionUseCase {
+	return &VoidTransactionUseCase{repo}
+}
+
+// VoidTransaction cancels a pending transaction, making the funds it reserved
+// available again.
+func (uc *VoidTransactionUseCase) VoidTransaction(ctx context.Context, id int64) (*entities.Transaction, error) {
+	return uc.repo.UpdateTransaction(ctx, id, func(t *entities.Transaction) error {
+		return t.Void()
+	})
+}
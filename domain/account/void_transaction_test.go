@@ -0,0 +1,26 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoidTransactionUseCase_VoidTransaction(t *testing.T) {
+	repo := &mockUpdateTransactionRepository{transaction: pendingTransaction()}
+	uc := NewVoidTransactionUseCase(repo)
+
+	got, err := uc.VoidTransaction(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.TransactionVoided, got.Status)
+
+	// Voided transactions cannot be posted any more
+	_, err = NewPostTransactionUseCase(repo).PostTransaction(context.Background(), 7)
+	assert.ErrorIs(t, err, domain.ErrTransactionNotPending)
+
+	_, err = uc.VoidTransaction(context.Background(), 8)
+	assert.ErrorIs(t, err, domain.ErrTransactionNotFound)
+}
@@ -0,0 +1,50 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// WebhookDeliveriesRepository returns a page of the deliveries of a webhook
+// subscription, newest first, failing with domain.ErrWebhookNotFound if it
+// does not exist.
+type WebhookDeliveriesRepository interface {
+	WebhookDeliveries(ctx context.Context, query entities.WebhookDeliveryQuery) ([]entities.WebhookDelivery, error)
+}
+
+// WebhookDeliveriesUseCase is a use case for reading the delivery log of a
+// webhook subscription.
+type WebhookDeliveriesUseCase struct {
+	repo WebhookDeliveriesRepository
+}
+
+// NewWebhookDeliveriesUseCase creates a new WebhookDeliveriesUseCase.
+func NewWebhookDeliveriesUseCase(repo WebhookDeliveriesRepository) *WebhookDeliveriesUseCase {
+	return &WebhookDeliveriesUseCase{repo}
+}
+
+// WebhookDeliveries returns a page of the deliveries of the subscription
+// with the outcome of their last attempt. The limit defaults to
+// DefaultHistoryLimit and may not exceed MaxHistoryLimit.
+func (uc *WebhookDeliveriesUseCase) WebhookDeliveries(ctx context.Context, query entities.WebhookDeliveryQuery) ([]entities.WebhookDelivery, error) {
+	if query.Limit == 0 {
+		query.Limit = DefaultHistoryLimit
+	}
+
+	if query.Limit < 0 || query.Limit > MaxHistoryLimit {
+		return nil, domain.ErrInvalidPageLimit
+	}
+
+	deliveries, err := uc.repo.WebhookDeliveries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if deliveries == nil {
+		deliveries = []entities.WebhookDelivery{}
+	}
+
+	return deliveries, nil
+}
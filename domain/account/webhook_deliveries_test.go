@@ -0,0 +1,84 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockWebhookDeliveriesRepository struct {
+	deliveries []entities.WebhookDelivery
+	query      entities.WebhookDeliveryQuery
+}
+
+func (m *mockWebhookDeliveriesRepository) WebhookDeliveries(ctx context.Context, query entities.WebhookDeliveryQuery) ([]entities.WebhookDelivery, error) {
+	if query.WebhookID != 1 {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	m.query = query
+	return m.deliveries, nil
+}
+
+func TestWebhookDeliveriesUseCase_WebhookDeliveries(t *testing.T) {
+	deliveries := []entities.WebhookDelivery{
+		{ID: 2, WebhookID: 1, EventID: 4, Status: entities.WebhookDeliveryPending, Attempts: 1, LastStatusCode: 500},
+		{ID: 1, WebhookID: 1, EventID: 3, Status: entities.WebhookDeliverySucceeded, Attempts: 1, LastStatusCode: 200},
+	}
+
+	tests := []struct {
+		name      string
+		repo      *mockWebhookDeliveriesRepository
+		query     entities.WebhookDeliveryQuery
+		want      []entities.WebhookDelivery
+		wantQuery entities.WebhookDeliveryQuery
+		wantErr   error
+	}{
+		{
+			name:      "should default the limit",
+			repo:      &mockWebhookDeliveriesRepository{deliveries: deliveries},
+			query:     entities.WebhookDeliveryQuery{WebhookID: 1},
+			want:      deliveries,
+			wantQuery: entities.WebhookDeliveryQuery{WebhookID: 1, Limit: DefaultHistoryLimit},
+		},
+		{
+			name:      "should page before a delivery",
+			repo:      &mockWebhookDeliveriesRepository{deliveries: deliveries[1:]},
+			query:     entities.WebhookDeliveryQuery{WebhookID: 1, Before: 2, Limit: 1},
+			want:      deliveries[1:],
+			wantQuery: entities.WebhookDeliveryQuery{WebhookID: 1, Before: 2, Limit: 1},
+		},
+		{
+			name:      "should return an empty log",
+			repo:      &mockWebhookDeliveriesRepository{},
+			query:     entities.WebhookDeliveryQuery{WebhookID: 1},
+			want:      []entities.WebhookDelivery{},
+			wantQuery: entities.WebhookDeliveryQuery{WebhookID: 1, Limit: DefaultHistoryLimit},
+		},
+		{
+			name:    "should reject limits over the maximum",
+			repo:    &mockWebhookDeliveriesRepository{},
+			query:   entities.WebhookDeliveryQuery{WebhookID: 1, Limit: MaxHistoryLimit + 1},
+			wantErr: domain.ErrInvalidPageLimit,
+		},
+		{
+			name:    "should return not found",
+			repo:    &mockWebhookDeliveriesRepository{},
+			query:   entities.WebhookDeliveryQuery{WebhookID: 2},
+			wantErr: domain.ErrWebhookNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewWebhookDeliveriesUseCase(tt.repo)
+			got, err := uc.WebhookDeliveries(context.Background(), tt.query)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantQuery, tt.repo.query)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package account
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// WithdrawRepository books the withdrawal under the same checks as
+// TransferRepository.
+type WithdrawRepository interface {
+	TransferWithFundsCheck(ctx context.Context, entries entities.DoubleEntry) (int64, error)
+}
+
+// WithdrawUseCase is a use case for withdrawing money from an account.
+type WithdrawUseCase struct {
+	repo WithdrawRepository
+}
+
+// NewWithdrawUseCase creates a new WithdrawUseCase.
+func NewWithdrawUseCase(repo WithdrawRepository) *WithdrawUseCase {
+	return &WithdrawUseCase{repo}
+}
+
+type WithdrawInput struct {
+	Account  string
+	Amount   int64
+	Currency string
+
+	// IdempotencyKey, when set, makes retrying the withdrawal safe.
+	IdempotencyKey string
+}
+
+// Withdraw moves money out of an account to the external account and returns
+// the transaction ID.
+func (uc *WithdrawUseCase) Withdraw(ctx context.Context, input WithdrawInput) (int64, error) {
+	currency, err := entities.NewCurrency(input.Currency)
+	if err != nil {
+		return 0, err
+	}
+
+	credit, err := entities.NewEntry(0, input.Account, entities.Credit, input.Amount, currency, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	debit, err := entities.NewEntry(0, ExternalAccount, entities.Debit, input.Amount, currency, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	de, err := entities.NewDoubleEntry(*debit, *credit)
+	if err != nil {
+		return 0, err
+	}
+	de.IdempotencyKey = input.IdempotencyKey
+
+	return uc.repo.TransferWithFundsCheck(ctx, *de)
+}
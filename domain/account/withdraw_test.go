@@ -0,0 +1,74 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithdrawUseCase_Withdraw(t *testing.T) {
+	testCases := []struct {
+		name          string
+		amount        int64
+		balance       int64
+		currency      string
+		expectedID    int64
+		expectedError error
+	}{
+		{
+			name:       "Sufficient funds",
+			amount:     100,
+			balance:    200,
+			currency:   "USD",
+			expectedID: 1,
+		},
+		{
+			name:          "Insufficient funds",
+			amount:        200,
+			balance:       100,
+			currency:      "USD",
+			expectedError: domain.ErrInsufficientFunds,
+		},
+		{
+			name:          "Invalid currency",
+			amount:        100,
+			balance:       200,
+			currency:      "XXX",
+			expectedError: domain.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var booked *entities.DoubleEntry
+			repo := &mockTransferRepo{
+				transferFunc: func(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+					if tc.balance < entries.Credit.Amount {
+						return 0, domain.ErrInsufficientFunds
+					}
+					booked = &entries
+					return 1, nil
+				},
+			}
+
+			id, err := NewWithdrawUseCase(repo).Withdraw(context.Background(), WithdrawInput{
+				Account:        "account1",
+				Amount:         tc.amount,
+				Currency:       tc.currency,
+				IdempotencyKey: "key",
+			})
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedID, id)
+			if tc.expectedError == nil {
+				assert.Equal(t, "account1", booked.Credit.Account)
+				assert.Equal(t, ExternalAccount, booked.Debit.Account)
+				assert.Equal(t, tc.amount, booked.Debit.Amount)
+				assert.Equal(t, "key", booked.IdempotencyKey)
+			}
+		})
+	}
+}
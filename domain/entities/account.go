@@ -0,0 +1,204 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+)
+
+// AccountStatus is the lifecycle state of an account.
+type AccountStatus string
+
+const (
+	// AccountOpen accounts accept postings.
+	AccountOpen AccountStatus = "open"
+	// AccountFrozen accounts reject postings until they are reopened.
+	AccountFrozen AccountStatus = "frozen"
+	// AccountClosed accounts reject postings for good.
+	AccountClosed AccountStatus = "closed"
+)
+
+// AccountType is the class of an account in the chart of accounts. It
+// determines the account's normal balance side.
+type AccountType string
+
+const (
+	// Asset accounts hold resources, e.g. customer funds or cash.
+	Asset AccountType = "asset"
+	// Liability accounts hold obligations to others.
+	Liability AccountType = "liability"
+	// Equity accounts hold the owners' residual interest.
+	Equity AccountType = "equity"
+	// Revenue accounts hold income, e.g. collected fees.
+	Revenue AccountType = "revenue"
+	// Expense accounts hold costs.
+	Expense AccountType = "expense"
+)
+
+// NewAccountType validates an account type.
+func NewAccountType(accountType string) (AccountType, error) {
+	t := AccountType(accountType)
+	switch t {
+	case Asset, Liability, Equity, Revenue, Expense:
+		return t, nil
+	}
+
+	return "", domain.ErrInvalidAccountType
+}
+
+// NormalBalance returns the side that increases accounts of this type: debit
+// for assets and expenses, credit for liabilities, equity and revenue.
+func (t AccountType) NormalBalance() Direction {
+	if t == Asset || t == Expense {
+		return Debit
+	}
+
+	return Credit
+}
+
+// Normalize turns a debits minus credits amount into a balance on the
+// normal side of accounts of this type, positive when the account holds funds.
+func (t AccountType) Normalize(amount int64) int64 {
+	if t.NormalBalance() == Credit {
+		return -amount
+	}
+
+	return amount
+}
+
+// UnlimitedOverdraft is the overdraft limit of accounts that can go
+// negative without bound, such as the system accounts funding deposits.
+const UnlimitedOverdraft int64 = -1
+
+// Account is a registered ledger account.
+type Account struct {
+	ID string
+	// Owner references the customer or system owning the account.
+	Owner string
+	// Parent is the ID of the account this one rolls up into, empty for the
+	// roots of the chart of accounts.
+	Parent string
+	Type   AccountType
+	Status AccountStatus
+	// OverdraftLimit is how far below zero postings may take the balance of
+	// the account in each currency, or UnlimitedOverdraft.
+	OverdraftLimit int64
+	CreatedAt      *time.Time
+}
+
+// NewAccount creates a new open Account with ID and type validation.
+func NewAccount(id, owner string, accountType AccountType) (*Account, error) {
+	if err := ValidateAccount(id); err != nil {
+		return nil, err
+	}
+
+	if _, err := NewAccountType(string(accountType)); err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		ID:     id,
+		Owner:  owner,
+		Type:   accountType,
+		Status: AccountOpen,
+	}, nil
+}
+
+// SetParent makes the account a child of parent. Both must share the same
+// type, so the balances of a subtree add up on the same normal side.
+func (a *Account) SetParent(parent Account) error {
+	if parent.ID == a.ID || parent.Type != a.Type {
+		return domain.ErrInvalidParent
+	}
+
+	a.Parent = parent.ID
+	return nil
+}
+
+// ValidateOverdraftLimit checks that limit is either non-negative or
+// UnlimitedOverdraft.
+func ValidateOverdraftLimit(limit int64) error {
+	if limit < 0 && limit != UnlimitedOverdraft {
+		return domain.ErrInvalidOverdraftLimit
+	}
+
+	return nil
+}
+
+// SetOverdraftLimit changes the overdraft limit of the account.
+func (a *Account) SetOverdraftLimit(limit int64) error {
+	if err := ValidateOverdraftLimit(limit); err != nil {
+		return err
+	}
+
+	a.OverdraftLimit = limit
+	return nil
+}
+
+// Covers reports whether balance, on the account's normal side, is within
+// its overdraft limit.
+func (a Account) Covers(balance int64) bool {
+	return a.OverdraftLimit == UnlimitedOverdraft || balance >= -a.OverdraftLimit
+}
+
+// OverdraftLimitChange records a change of the overdraft limit of an account.
+type OverdraftLimitChange struct {
+	ID       int64
+	Account  string
+	OldLimit int64
+	NewLimit int64
+	// Reason explains the change, e.g. the approval of a credit line.
+	Reason    string
+	CreatedAt *time.Time
+}
+
+// NewAccountStatus validates an account status.
+func NewAccountStatus(status string) (AccountStatus, error) {
+	s := AccountStatus(status)
+	if s != AccountOpen && s != AccountFrozen && s != AccountClosed {
+		return "", domain.ErrInvalidAccountStatus
+	}
+
+	return s, nil
+}
+
+// SetStatus moves the account to status. Open and frozen accounts can move
+// between each other or be closed; closed accounts cannot be reopened.
+func (a *Account) SetStatus(status AccountStatus) error {
+	if _, err := NewAccountStatus(string(status)); err != nil {
+		return err
+	}
+
+	if a.Status == AccountClosed && status != AccountClosed {
+		return domain.ErrInvalidStatusTransition
+	}
+
+	a.Status = status
+	return nil
+}
+
+// CanPost reports whether entries can be booked on the account.
+func (a Account) CanPost() error {
+	switch a.Status {
+	case AccountFrozen:
+		return domain.ErrAccountFrozen
+	case AccountClosed:
+		return domain.ErrAccountClosed
+	}
+
+	return nil
+}
+
+// AccountsQuery selects a page of registered accounts, ordered by ID.
+type AccountsQuery struct {
+	// Owner, Parent, Type and Status keep only matching accounts when set.
+	Owner  string
+	Parent string
+	Type   AccountType
+	Status AccountStatus
+
+	// After, when set, continues the listing past the account with this ID.
+	After string
+
+	Limit int
+}
@@ -0,0 +1,161 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		accountType AccountType
+		want        *Account
+		wantErr     error
+	}{
+		{
+			name:        "should create an open account",
+			id:          "account1",
+			accountType: Liability,
+			want:        &Account{ID: "account1", Owner: "customer-1", Type: Liability, Status: AccountOpen},
+		},
+		{
+			name:        "should fail to create an account with an empty ID",
+			id:          "",
+			accountType: Asset,
+			wantErr:     domain.ErrEmptyAccount,
+		},
+		{
+			name:        "should fail to create an account with an invalid ID",
+			id:          "account 1",
+			accountType: Asset,
+			wantErr:     domain.ErrInvalidAccount,
+		},
+		{
+			name:        "should fail to create an account with an unknown type",
+			id:          "account1",
+			accountType: "income",
+			wantErr:     domain.ErrInvalidAccountType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAccount(tt.id, "customer-1", tt.accountType)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAccount_SetStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    AccountStatus
+		to      AccountStatus
+		wantErr error
+	}{
+		{name: "freeze", from: AccountOpen, to: AccountFrozen},
+		{name: "unfreeze", from: AccountFrozen, to: AccountOpen},
+		{name: "close open", from: AccountOpen, to: AccountClosed},
+		{name: "close frozen", from: AccountFrozen, to: AccountClosed},
+		{name: "close closed", from: AccountClosed, to: AccountClosed},
+		{name: "reopen closed", from: AccountClosed, to: AccountOpen, wantErr: domain.ErrInvalidStatusTransition},
+		{name: "freeze closed", from: AccountClosed, to: AccountFrozen, wantErr: domain.ErrInvalidStatusTransition},
+		{name: "unknown status", from: AccountOpen, to: "deleted", wantErr: domain.ErrInvalidAccountStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Account{ID: "account1", Status: tt.from}
+			err := a.SetStatus(tt.to)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.to, a.Status)
+			} else {
+				assert.Equal(t, tt.from, a.Status)
+			}
+		})
+	}
+}
+
+func TestAccount_SetParent(t *testing.T) {
+	tests := []struct {
+		name    string
+		parent  Account
+		wantErr error
+	}{
+		{name: "same type", parent: Account{ID: "assets:bank", Type: Asset}},
+		{name: "different type", parent: Account{ID: "liabilities", Type: Liability}, wantErr: domain.ErrInvalidParent},
+		{name: "itself", parent: Account{ID: "assets:bank:checking", Type: Asset}, wantErr: domain.ErrInvalidParent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Account{ID: "assets:bank:checking", Type: Asset}
+			err := a.SetParent(tt.parent)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.parent.ID, a.Parent)
+			} else {
+				assert.Empty(t, a.Parent)
+			}
+		})
+	}
+}
+
+func TestAccount_SetOverdraftLimit(t *testing.T) {
+	a := Account{ID: "account1"}
+	assert.NoError(t, a.SetOverdraftLimit(500))
+	assert.Equal(t, int64(500), a.OverdraftLimit)
+	assert.NoError(t, a.SetOverdraftLimit(UnlimitedOverdraft))
+	assert.Equal(t, UnlimitedOverdraft, a.OverdraftLimit)
+	assert.Equal(t, domain.ErrInvalidOverdraftLimit, a.SetOverdraftLimit(-2))
+	assert.Equal(t, UnlimitedOverdraft, a.OverdraftLimit)
+}
+
+func TestAccount_Covers(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   int64
+		balance int64
+		want    bool
+	}{
+		{name: "no overdraft, positive balance", limit: 0, balance: 10, want: true},
+		{name: "no overdraft, zero balance", limit: 0, balance: 0, want: true},
+		{name: "no overdraft, negative balance", limit: 0, balance: -1, want: false},
+		{name: "within limit", limit: 500, balance: -500, want: true},
+		{name: "beyond limit", limit: 500, balance: -501, want: false},
+		{name: "unlimited", limit: UnlimitedOverdraft, balance: -1_000_000, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Account{OverdraftLimit: tt.limit}.Covers(tt.balance))
+		})
+	}
+}
+
+func TestAccount_CanPost(t *testing.T) {
+	assert.NoError(t, Account{Status: AccountOpen}.CanPost())
+	assert.Equal(t, domain.ErrAccountFrozen, Account{Status: AccountFrozen}.CanPost())
+	assert.Equal(t, domain.ErrAccountClosed, Account{Status: AccountClosed}.CanPost())
+}
+
+func TestAccountType_Normalize(t *testing.T) {
+	tests := []struct {
+		accountType AccountType
+		normal      Direction
+		want        int64
+	}{
+		{accountType: Asset, normal: Debit, want: 100},
+		{accountType: Expense, normal: Debit, want: 100},
+		{accountType: Liability, normal: Credit, want: -100},
+		{accountType: Equity, normal: Credit, want: -100},
+		{accountType: Revenue, normal: Credit, want: -100},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.accountType), func(t *testing.T) {
+			assert.Equal(t, tt.normal, tt.accountType.NormalBalance())
+			assert.Equal(t, tt.want, tt.accountType.Normalize(100))
+		})
+	}
+}
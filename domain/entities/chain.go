@@ -0,0 +1,30 @@
+package entities
+
+// ChainLink is an entry with the hash stored for it in the ledger's hash
+// chain.
+type ChainLink struct {
+	Entry Entry
+	Hash  string
+}
+
+// ChainBreak is the first entry whose stored hash does not match the one
+// computed from its content and the previous entry's hash.
+type ChainBreak struct {
+	EntryID  int64
+	Expected string
+	Stored   string
+}
+
+// ChainVerification is the outcome of walking the ledger's hash chain.
+type ChainVerification struct {
+	// Verified is the number of entries whose hash matched, up to the first
+	// broken link.
+	Verified int64
+	// Break is nil when the whole chain is intact.
+	Break *ChainBreak
+}
+
+// Valid reports whether the whole chain is intact.
+func (v ChainVerification) Valid() bool {
+	return v.Break == nil
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    Currency
+		wantErr error
+	}{
+		{
+			name:    "should create a currency with cents",
+			code:    "EUR",
+			want:    Currency{Code: "EUR", Exponent: 2},
+			wantErr: nil,
+		},
+		{
+			name:    "should create a currency without minor unit",
+			code:    "JPY",
+			want:    Currency{Code: "JPY", Exponent: 0},
+			wantErr: nil,
+		},
+		{
+			name:    "should create a currency with three decimals",
+			code:    "OMR",
+			want:    Currency{Code: "OMR", Exponent: 3},
+			wantErr: nil,
+		},
+		{
+			name:    "should create a zero-decimal currency",
+			code:    "ISK",
+			want:    Currency{Code: "ISK", Exponent: 0},
+			wantErr: nil,
+		},
+		{
+			name:    "should create a currency with four decimals",
+			code:    "CLF",
+			want:    Currency{Code: "CLF", Exponent: 4},
+			wantErr: nil,
+		},
+		{
+			name:    "should accept lower case codes",
+			code:    "usd",
+			want:    Currency{Code: "USD", Exponent: 2},
+			wantErr: nil,
+		},
+		{
+			name:    "should fail to create an unknown currency",
+			code:    "XYZ",
+			want:    Currency{},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+		{
+			name:    "should fail to create a currency without minor unit",
+			code:    "XAU",
+			want:    Currency{},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCurrency(tt.code)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package entities
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/julioc98/ledger/domain"
@@ -16,34 +20,87 @@ const (
 	Credit Direction = "credit"
 )
 
+// accountPattern matches account identifiers: letters, digits, '_', '-', '.'
+// and ':' (for sub-accounts), starting with a letter or digit and fitting the
+// accounts.id column.
+var accountPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.:-]{0,254}$`)
+
+// ValidateAccount checks that account is a well-formed account identifier.
+func ValidateAccount(account string) error {
+	if account == "" {
+		return domain.ErrEmptyAccount
+	}
+
+	if !accountPattern.MatchString(account) {
+		return domain.ErrInvalidAccount
+	}
+
+	return nil
+}
+
 // Entry is a representation of a single entry in the ledger.
 type Entry struct {
-	ID        int64
-	Account   string
-	Direction Direction
-	Amount    int64
-	CreatedAt *time.Time
+	ID            int64
+	TransactionID int64
+	Account       string
+	Direction     Direction
+	Amount        int64
+	Currency      Currency
+	// Status is the status of the transaction the entry belongs to, and
+	// ReversalOf the transaction it reverses, if any.
+	Status     TransactionStatus
+	ReversalOf int64
+	CreatedAt  *time.Time
 }
 
-// NewEntry creates a new Entry with direction validation.
-func NewEntry(id int64, account string, direction Direction, amount int64, createdAt *time.Time) (*Entry, error) {
+// NewEntry creates a new Entry with direction, account and amount validation.
+func NewEntry(id int64, account string, direction Direction, amount int64, currency Currency, createdAt *time.Time) (*Entry, error) {
 	if direction != Debit && direction != Credit {
 		return nil, domain.ErrIvalidDirection
 	}
 
+	if err := ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	if amount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
 	return &Entry{
 		ID:        id,
 		Account:   account,
 		Direction: direction,
 		Amount:    amount,
+		Currency:  currency,
 		CreatedAt: createdAt,
 	}, nil
 }
 
+// Hash returns the entry's link in the ledger's hash chain: a SHA-256 digest
+// of prev, the hash of the entry before it (empty for the first one), and the
+// entry's transaction, account, direction, amount, currency and creation time
+// in microseconds since the Unix epoch.
+func (e Entry) Hash(prev string) string {
+	var createdAt int64
+	if e.CreatedAt != nil {
+		createdAt = e.CreatedAt.UnixMicro()
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%d\n%s\n%s\n%d\n%s\n%d",
+		prev, e.TransactionID, e.Account, e.Direction, e.Amount, e.Currency.Code, createdAt)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // DoubleEntry is a representation of a double entry in the ledger.
 type DoubleEntry struct {
 	Debit  Entry
 	Credit Entry
+
+	// IdempotencyKey, when set, makes booking the entries safe to retry.
+	IdempotencyKey string
 }
 
 // NewDoubleEntry creates a new DoubleEntry with validation.
@@ -53,8 +110,112 @@ func NewDoubleEntry(debit, credit Entry) (*DoubleEntry, error) {
 		return nil, domain.ErrAmountsNotMatch
 	}
 
+	// Validate that both legs are in the same currency.
+	if debit.Currency != credit.Currency {
+		return nil, domain.ErrCurrenciesNotMatch
+	}
+
 	return &DoubleEntry{
 		Debit:  debit,
 		Credit: credit,
 	}, nil
 }
+
+// JournalEntry returns the double entry as a two-legged JournalEntry.
+func (de DoubleEntry) JournalEntry() JournalEntry {
+	return JournalEntry{
+		Entries:        []Entry{de.Credit, de.Debit},
+		IdempotencyKey: de.IdempotencyKey,
+	}
+}
+
+// JournalEntry is a representation of a balanced set of entries with any
+// number of legs, all in the same currency.
+type JournalEntry struct {
+	Entries []Entry
+
+	// IdempotencyKey, when set, makes booking the entries safe to retry.
+	IdempotencyKey string
+
+	// Pending books the entries in a pending transaction, to be posted or
+	// voided later.
+	Pending bool
+
+	// ReversalOf, when set, links the transaction booking the entries to the
+	// transaction they reverse.
+	ReversalOf int64
+}
+
+// NewJournalEntry creates a new JournalEntry with validation.
+func NewJournalEntry(entries ...Entry) (*JournalEntry, error) {
+	var debits, credits int64
+	var hasDebit, hasCredit bool
+	for _, e := range entries {
+		if e.Currency != entries[0].Currency {
+			return nil, domain.ErrCurrenciesNotMatch
+		}
+
+		switch e.Direction {
+		case Debit:
+			debits += e.Amount
+			hasDebit = true
+		case Credit:
+			credits += e.Amount
+			hasCredit = true
+		default:
+			return nil, domain.ErrIvalidDirection
+		}
+	}
+
+	if !hasDebit || !hasCredit {
+		return nil, domain.ErrJournalEntryLegs
+	}
+
+	// Validate that the debits sum to the credits.
+	if debits != credits {
+		return nil, domain.ErrUnbalancedJournalEntry
+	}
+
+	return &JournalEntry{
+		Entries: entries,
+	}, nil
+}
+
+// Net returns the net movement of each account in the journal entry, debits
+// minus credits, in the currency of the journal entry.
+func (je JournalEntry) Net() map[string]int64 {
+	net := make(map[string]int64, len(je.Entries))
+	for _, e := range je.Entries {
+		if e.Direction == Debit {
+			net[e.Account] += e.Amount
+		} else {
+			net[e.Account] -= e.Amount
+		}
+	}
+
+	return net
+}
+
+// Fingerprint returns a digest of the legs of the journal entry, used to tell
+// a retry of the same request apart from a different request reusing its
+// idempotency key.
+func (je JournalEntry) Fingerprint() string {
+	h := sha256.New()
+	for _, e := range je.Entries {
+		fmt.Fprintf(h, "%s|%s|%d|%s\n", e.Account, e.Direction, e.Amount, e.Currency.Code)
+	}
+	if je.Pending {
+		fmt.Fprintln(h, "pending")
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Currency returns the currency of the journal entry.
+func (je JournalEntry) Currency() Currency {
+	if len(je.Entries) == 0 {
+		return Currency{}
+	}
+
+	return je.Entries[0].Currency
+}
@@ -1,9 +1,11 @@
 package entities
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/julioc98/ledger/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,6 +15,7 @@ func TestNewEntry(t *testing.T) {
 		account   string
 		direction Direction
 		amount    int64
+		currency  Currency
 		createdAt *time.Time
 	}
 	tests := []struct {
@@ -28,6 +31,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "account",
 				direction: Debit,
 				amount:    100,
+				currency:  Currency{Code: "USD", Exponent: 2},
 				createdAt: nil,
 			},
 			want: &Entry{
@@ -35,6 +39,7 @@ func TestNewEntry(t *testing.T) {
 				Account:   "account",
 				Direction: Debit,
 				Amount:    100,
+				Currency:  Currency{Code: "USD", Exponent: 2},
 				CreatedAt: nil,
 			},
 			wantErr: false,
@@ -46,6 +51,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "account",
 				direction: Credit,
 				amount:    100,
+				currency:  Currency{Code: "USD", Exponent: 2},
 				createdAt: nil,
 			},
 			want: &Entry{
@@ -53,6 +59,7 @@ func TestNewEntry(t *testing.T) {
 				Account:   "account",
 				Direction: Credit,
 				Amount:    100,
+				Currency:  Currency{Code: "USD", Exponent: 2},
 				CreatedAt: nil,
 			},
 			wantErr: false,
@@ -64,6 +71,59 @@ func TestNewEntry(t *testing.T) {
 				account:   "account",
 				direction: "invalid",
 				amount:    100,
+				currency:  Currency{Code: "USD", Exponent: 2},
+				createdAt: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "should fail to create a new entry with zero amount",
+			args: args{
+				id:        1,
+				account:   "account",
+				direction: Debit,
+				amount:    0,
+				currency:  Currency{Code: "USD", Exponent: 2},
+				createdAt: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "should fail to create a new entry with negative amount",
+			args: args{
+				id:        1,
+				account:   "account",
+				direction: Debit,
+				amount:    -500,
+				currency:  Currency{Code: "USD", Exponent: 2},
+				createdAt: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "should fail to create a new entry with empty account",
+			args: args{
+				id:        1,
+				account:   "",
+				direction: Debit,
+				amount:    100,
+				currency:  Currency{Code: "USD", Exponent: 2},
+				createdAt: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "should fail to create a new entry with invalid account",
+			args: args{
+				id:        1,
+				account:   "account 1",
+				direction: Debit,
+				amount:    100,
+				currency:  Currency{Code: "USD", Exponent: 2},
 				createdAt: nil,
 			},
 			want:    nil,
@@ -72,7 +132,7 @@ func TestNewEntry(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewEntry(tt.args.id, tt.args.account, tt.args.direction, tt.args.amount, tt.args.createdAt)
+			got, err := NewEntry(tt.args.id, tt.args.account, tt.args.direction, tt.args.amount, tt.args.currency, tt.args.createdAt)
 
 			if !assert.Equal(t, tt.wantErr, err != nil) {
 				t.Errorf("NewEntry() error = %v, wantErr %v", err, tt.wantErr)
@@ -85,6 +145,26 @@ func TestNewEntry(t *testing.T) {
 	}
 }
 
+func TestValidateAccount(t *testing.T) {
+	tests := []struct {
+		account string
+		wantErr error
+	}{
+		{account: "account1"},
+		{account: "assets:cash_usd-01.main"},
+		{account: "", wantErr: domain.ErrEmptyAccount},
+		{account: "account 1", wantErr: domain.ErrInvalidAccount},
+		{account: "-account", wantErr: domain.ErrInvalidAccount},
+		{account: "account|1", wantErr: domain.ErrInvalidAccount},
+		{account: strings.Repeat("a", 256), wantErr: domain.ErrInvalidAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.account, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, ValidateAccount(tt.account))
+		})
+	}
+}
+
 func TestNewDoubleEntry(t *testing.T) {
 	type args struct {
 		debit  Entry
@@ -132,6 +212,29 @@ func TestNewDoubleEntry(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "should fail to create a new double entry with different currencies",
+			args: args{
+				debit: Entry{
+					ID:        1,
+					Account:   "account",
+					Direction: Debit,
+					Amount:    100,
+					Currency:  Currency{Code: "USD", Exponent: 2},
+					CreatedAt: nil,
+				},
+				credit: Entry{
+					ID:        2,
+					Account:   "account",
+					Direction: Credit,
+					Amount:    100,
+					Currency:  Currency{Code: "EUR", Exponent: 2},
+					CreatedAt: nil,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "should fail to create a new double entry with different amounts",
 			args: args{
@@ -166,3 +269,147 @@ func TestNewDoubleEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestNewJournalEntry(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []Entry
+		want    *JournalEntry
+		wantErr error
+	}{
+		{
+			name: "should create a new journal entry with three legs",
+			entries: []Entry{
+				{Account: "payer", Direction: Credit, Amount: 100},
+				{Account: "merchant", Direction: Debit, Amount: 97},
+				{Account: "fees", Direction: Debit, Amount: 3},
+			},
+			want: &JournalEntry{
+				Entries: []Entry{
+					{Account: "payer", Direction: Credit, Amount: 100},
+					{Account: "merchant", Direction: Debit, Amount: 97},
+					{Account: "fees", Direction: Debit, Amount: 3},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should fail to create an unbalanced journal entry",
+			entries: []Entry{
+				{Account: "payer", Direction: Credit, Amount: 100},
+				{Account: "merchant", Direction: Debit, Amount: 97},
+				{Account: "fees", Direction: Debit, Amount: 2},
+			},
+			want:    nil,
+			wantErr: domain.ErrUnbalancedJournalEntry,
+		},
+		{
+			name: "should fail to create a journal entry with mixed currencies",
+			entries: []Entry{
+				{Account: "payer", Direction: Credit, Amount: 100, Currency: Currency{Code: "USD", Exponent: 2}},
+				{Account: "merchant", Direction: Debit, Amount: 100, Currency: Currency{Code: "EUR", Exponent: 2}},
+			},
+			want:    nil,
+			wantErr: domain.ErrCurrenciesNotMatch,
+		},
+		{
+			name: "should fail to create a journal entry without credits",
+			entries: []Entry{
+				{Account: "merchant", Direction: Debit, Amount: 100},
+			},
+			want:    nil,
+			wantErr: domain.ErrJournalEntryLegs,
+		},
+		{
+			name: "should fail to create a journal entry with invalid direction",
+			entries: []Entry{
+				{Account: "payer", Direction: Credit, Amount: 100},
+				{Account: "merchant", Direction: "invalid", Amount: 100},
+			},
+			want:    nil,
+			wantErr: domain.ErrIvalidDirection,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewJournalEntry(tt.entries...)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestJournalEntry_Net(t *testing.T) {
+	je := JournalEntry{
+		Entries: []Entry{
+			{Account: "payer", Direction: Credit, Amount: 100},
+			{Account: "merchant", Direction: Debit, Amount: 97},
+			{Account: "fees", Direction: Debit, Amount: 3},
+			{Account: "merchant", Direction: Credit, Amount: 10},
+		},
+	}
+
+	assert.Equal(t, map[string]int64{"payer": -100, "merchant": 87, "fees": 3}, je.Net())
+}
+
+func TestJournalEntry_Fingerprint(t *testing.T) {
+	je := JournalEntry{
+		Entries: []Entry{
+			{Account: "account1", Direction: Credit, Amount: 100},
+			{Account: "account2", Direction: Debit, Amount: 100},
+		},
+	}
+	same := JournalEntry{
+		Entries: []Entry{
+			{ID: 7, Account: "account1", Direction: Credit, Amount: 100},
+			{ID: 8, Account: "account2", Direction: Debit, Amount: 100},
+		},
+		IdempotencyKey: "key",
+	}
+	different := JournalEntry{
+		Entries: []Entry{
+			{Account: "account1", Direction: Credit, Amount: 200},
+			{Account: "account2", Direction: Debit, Amount: 200},
+		},
+	}
+	otherCurrency := JournalEntry{
+		Entries: []Entry{
+			{Account: "account1", Direction: Credit, Amount: 100, Currency: Currency{Code: "EUR", Exponent: 2}},
+			{Account: "account2", Direction: Debit, Amount: 100, Currency: Currency{Code: "EUR", Exponent: 2}},
+		},
+	}
+
+	assert.Len(t, je.Fingerprint(), 64)
+	assert.Equal(t, je.Fingerprint(), same.Fingerprint())
+	assert.NotEqual(t, je.Fingerprint(), different.Fingerprint())
+	assert.NotEqual(t, je.Fingerprint(), otherCurrency.Fingerprint())
+
+	pending := je
+	pending.Pending = true
+	assert.NotEqual(t, je.Fingerprint(), pending.Fingerprint())
+}
+
+func TestEntry_Hash(t *testing.T) {
+	createdAt := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	e := Entry{ID: 1, TransactionID: 7, Account: "jc", Direction: Debit, Amount: 100, Currency: Currency{Code: "USD", Exponent: 2}, CreatedAt: &createdAt}
+
+	// The format is shared with the migration that hashed the existing entries
+	assert.Equal(t, "eff415d4534a307efa50d1a2e30678fd85e0e9d13e04bef1975cc4ad79d34b59", e.Hash(""))
+
+	// The ID and the transaction's status are not part of the content
+	same := e
+	same.ID = 2
+	same.Status = TransactionVoided
+	assert.Equal(t, e.Hash(""), same.Hash(""))
+
+	assert.NotEqual(t, e.Hash(""), e.Hash(e.Hash("")))
+
+	tampered := e
+	tampered.Amount = 1000
+	assert.NotEqual(t, e.Hash(""), tampered.Hash(""))
+
+	tampered = e
+	later := createdAt.Add(time.Microsecond)
+	tampered.CreatedAt = &later
+	assert.NotEqual(t, e.Hash(""), tampered.Hash(""))
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventType is the kind of change an event announces.
+type EventType string
+
+const (
+	// EventTransactionPosted announces a transaction that was posted, either
+	// when it was booked or when a pending transaction was posted later.
+	EventTransactionPosted EventType = "TransactionPosted"
+)
+
+// Event is a change to the ledger recorded for downstream services. It is
+// stored in the same database transaction as the change, so committed
+// changes are never left unannounced.
+type Event struct {
+	ID            int64
+	Type          EventType
+	TransactionID int64
+	// Payload is the JSON document describing the change.
+	Payload   []byte
+	CreatedAt *time.Time
+}
+
+// NewTransactionPostedEvent creates the event announcing the posted
+// transaction, with the transaction and its entries as the payload.
+func NewTransactionPostedEvent(t Transaction) (*Event, error) {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Type:          EventTransactionPosted,
+		TransactionID: t.ID,
+		Payload:       payload,
+	}, nil
+}
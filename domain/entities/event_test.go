@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransactionPostedEvent(t *testing.T) {
+	transaction := Transaction{
+		ID:     7,
+		Status: TransactionPosted,
+		Entries: []Entry{
+			{ID: 1, TransactionID: 7, Account: "jc", Direction: Credit, Amount: 100, Currency: Currency{Code: "USD", Exponent: 2}, Status: TransactionPosted},
+			{ID: 2, TransactionID: 7, Account: "external", Direction: Debit, Amount: 100, Currency: Currency{Code: "USD", Exponent: 2}, Status: TransactionPosted},
+		},
+	}
+
+	e, err := NewTransactionPostedEvent(transaction)
+	assert.NoError(t, err)
+	assert.Equal(t, EventTransactionPosted, e.Type)
+	assert.Equal(t, int64(7), e.TransactionID)
+	assert.JSONEq(t, `{"ID":7,"Status":"posted","ReversalOf":0,"Entries":[`+
+		`{"ID":1,"TransactionID":7,"Account":"jc","Direction":"credit","Amount":100,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":null},`+
+		`{"ID":2,"TransactionID":7,"Account":"external","Direction":"debit","Amount":100,"Currency":{"Code":"USD","Exponent":2},"Status":"posted","ReversalOf":0,"CreatedAt":null}`+
+		`],"CreatedAt":null}`, string(e.Payload))
+}
@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+)
+
+// HistoryQuery selects a page of the entries of an account, newest first.
+type HistoryQuery struct {
+	Account string
+
+	// From and To bound the creation time to [From, To) when set.
+	From *time.Time
+	To   *time.Time
+
+	// Direction keeps only debits or only credits when set.
+	Direction Direction
+
+	// MinAmount and MaxAmount bound the amount, inclusively, when non-zero.
+	MinAmount int64
+	MaxAmount int64
+
+	// After, when set, continues the listing past the entry it points to.
+	After *Cursor
+
+	Limit int
+}
+
+// Cursor is the position of an entry in a history listing. Entries are
+// ordered by creation time and then by ID, so the pair is unique and stable.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// NewCursor returns the cursor pointing to the entry.
+func NewCursor(e Entry) Cursor {
+	var createdAt time.Time
+	if e.CreatedAt != nil {
+		createdAt = *e.CreatedAt
+	}
+
+	return Cursor{CreatedAt: createdAt.UTC(), ID: e.ID}
+}
+
+// String encodes the cursor as an opaque URL-safe token.
+func (c Cursor) String() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a token returned by Cursor.String.
+func ParseCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	var c Cursor
+	c.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	c.ID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	createdAt := time.Date(2023, 12, 5, 1, 56, 57, 324392000, time.UTC)
+	cursor := NewCursor(Entry{ID: 42, CreatedAt: &createdAt})
+
+	got, err := ParseCursor(cursor.String())
+	assert.NoError(t, err)
+	assert.Equal(t, &Cursor{CreatedAt: createdAt, ID: 42}, got)
+}
+
+func TestParseCursor(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "%%%"},
+		{name: "missing separator", token: "MjAyMw"},
+		{name: "invalid time", token: "bm90LWEtdGltZXw0Mg"},
+		{name: "invalid id", token: "MjAyMy0xMi0wNVQwMTo1Njo1N1p8Zm9v"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCursor(tt.token)
+			assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+			assert.Nil(t, got)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+)
+
+// HoldStatus is the lifecycle state of a hold.
+type HoldStatus string
+
+const (
+	// HoldActive holds reserve funds until they are captured, released or
+	// expire.
+	HoldActive HoldStatus = "active"
+	// HoldCaptured holds were settled by a transfer.
+	HoldCaptured HoldStatus = "captured"
+	// HoldReleased holds were given up before being captured.
+	HoldReleased HoldStatus = "released"
+	// HoldExpired holds lapsed without being captured.
+	HoldExpired HoldStatus = "expired"
+)
+
+// Hold reserves an amount of an account's funds, e.g. for a card
+// authorization, so it cannot be spent before the hold is settled.
+type Hold struct {
+	ID       int64
+	Account  string
+	Amount   int64
+	Currency Currency
+	Status   HoldStatus
+
+	// Captured is the amount transferred when the hold was captured, and
+	// TransactionID the transaction booking it.
+	Captured      int64
+	TransactionID int64
+
+	ExpiresAt time.Time
+	CreatedAt *time.Time
+}
+
+// NewHold creates a new active Hold with account, amount and expiry
+// validation.
+func NewHold(account string, amount int64, currency Currency, expiresAt, now time.Time) (*Hold, error) {
+	if err := ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	if amount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	if !expiresAt.After(now) {
+		return nil, domain.ErrInvalidHoldExpiry
+	}
+
+	return &Hold{
+		Account:   account,
+		Amount:    amount,
+		Currency:  currency,
+		Status:    HoldActive,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// active checks that the hold still reserves its funds at now.
+func (h Hold) active(now time.Time) error {
+	if h.Status != HoldActive {
+		return domain.ErrHoldNotActive
+	}
+
+	if !now.Before(h.ExpiresAt) {
+		return domain.ErrHoldExpired
+	}
+
+	return nil
+}
+
+// Capture settles the hold for amount, which may be less than the held
+// amount; the rest is released.
+func (h *Hold) Capture(amount int64, now time.Time) error {
+	if err := h.active(now); err != nil {
+		return err
+	}
+
+	if amount <= 0 {
+		return domain.ErrInvalidAmount
+	}
+
+	if amount > h.Amount {
+		return domain.ErrCaptureExceedsHold
+	}
+
+	h.Status = HoldCaptured
+	h.Captured = amount
+	return nil
+}
+
+// Release gives up the hold, making its funds available again.
+func (h *Hold) Release(now time.Time) error {
+	if err := h.active(now); err != nil {
+		return err
+	}
+
+	h.Status = HoldReleased
+	return nil
+}
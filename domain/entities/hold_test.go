@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHold(t *testing.T) {
+	usd := Currency{Code: "USD", Exponent: 2}
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		account   string
+		amount    int64
+		expiresAt time.Time
+		want      *Hold
+		wantErr   error
+	}{
+		{
+			name:      "should create an active hold",
+			account:   "account1",
+			amount:    100,
+			expiresAt: now.Add(time.Hour),
+			want:      &Hold{Account: "account1", Amount: 100, Currency: usd, Status: HoldActive, ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name:      "should fail to create a hold on an invalid account",
+			account:   "account 1",
+			amount:    100,
+			expiresAt: now.Add(time.Hour),
+			wantErr:   domain.ErrInvalidAccount,
+		},
+		{
+			name:      "should fail to create a hold with a non-positive amount",
+			account:   "account1",
+			amount:    0,
+			expiresAt: now.Add(time.Hour),
+			wantErr:   domain.ErrInvalidAmount,
+		},
+		{
+			name:      "should fail to create a hold that already expired",
+			account:   "account1",
+			amount:    100,
+			expiresAt: now,
+			wantErr:   domain.ErrInvalidHoldExpiry,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewHold(tt.account, tt.amount, usd, tt.expiresAt, now)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHold_Capture(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		status  HoldStatus
+		amount  int64
+		at      time.Time
+		wantErr error
+	}{
+		{name: "full", status: HoldActive, amount: 100, at: now},
+		{name: "partial", status: HoldActive, amount: 40, at: now},
+		{name: "more than held", status: HoldActive, amount: 101, at: now, wantErr: domain.ErrCaptureExceedsHold},
+		{name: "non-positive", status: HoldActive, amount: 0, at: now, wantErr: domain.ErrInvalidAmount},
+		{name: "expired", status: HoldActive, amount: 100, at: now.Add(time.Hour), wantErr: domain.ErrHoldExpired},
+		{name: "released", status: HoldReleased, amount: 100, at: now, wantErr: domain.ErrHoldNotActive},
+		{name: "captured twice", status: HoldCaptured, amount: 100, at: now, wantErr: domain.ErrHoldNotActive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := Hold{Amount: 100, Status: tt.status, ExpiresAt: now.Add(time.Hour)}
+			err := h.Capture(tt.amount, tt.at)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, HoldCaptured, h.Status)
+				assert.Equal(t, tt.amount, h.Captured)
+			} else {
+				assert.Equal(t, tt.status, h.Status)
+				assert.Zero(t, h.Captured)
+			}
+		})
+	}
+}
+
+func TestHold_Release(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	h := Hold{Amount: 100, Status: HoldActive, ExpiresAt: now.Add(time.Hour)}
+	assert.NoError(t, h.Release(now))
+	assert.Equal(t, HoldReleased, h.Status)
+	assert.Equal(t, domain.ErrHoldNotActive, h.Release(now))
+
+	h = Hold{Amount: 100, Status: HoldActive, ExpiresAt: now}
+	assert.Equal(t, domain.ErrHoldExpired, h.Release(now))
+}
@@ -0,0 +1,70 @@
+package entities
+
+// TrialBalanceLine is the sum of the posted debits and credits of an account
+// in a currency.
+type TrialBalanceLine struct {
+	Account  string
+	Currency Currency
+	Debits   int64
+	Credits  int64
+}
+
+// TrialBalanceTotal is the sum of the debits and credits of every account in
+// a currency.
+type TrialBalanceTotal struct {
+	Currency Currency
+	Debits   int64
+	Credits  int64
+}
+
+// Balanced reports whether the debits equal the credits.
+func (t TrialBalanceTotal) Balanced() bool {
+	return t.Debits == t.Credits
+}
+
+// TrialBalance lists the debit and credit totals of every account, with the
+// grand totals per currency.
+type TrialBalance struct {
+	Lines  []TrialBalanceLine
+	Totals []TrialBalanceTotal
+}
+
+// NewTrialBalance adds up the lines into the totals of each currency, in the
+// order the currencies first appear.
+func NewTrialBalance(lines []TrialBalanceLine) TrialBalance {
+	var totals []TrialBalanceTotal
+	index := make(map[string]int)
+	for _, l := range lines {
+		i, ok := index[l.Currency.Code]
+		if !ok {
+			i = len(totals)
+			index[l.Currency.Code] = i
+			totals = append(totals, TrialBalanceTotal{Currency: l.Currency})
+		}
+
+		totals[i].Debits += l.Debits
+		totals[i].Credits += l.Credits
+	}
+
+	return TrialBalance{Lines: lines, Totals: totals}
+}
+
+// Balanced reports whether the debits equal the credits in every currency.
+func (tb TrialBalance) Balanced() bool {
+	for _, t := range tb.Totals {
+		if !t.Balanced() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UnbalancedTransaction is a transaction whose debits and credits in a
+// currency do not net to zero.
+type UnbalancedTransaction struct {
+	TransactionID int64
+	Currency      Currency
+	Debits        int64
+	Credits       int64
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrialBalance(t *testing.T) {
+	usd := Currency{Code: "USD", Exponent: 2}
+	jpy := Currency{Code: "JPY", Exponent: 0}
+
+	tests := []struct {
+		name         string
+		lines        []TrialBalanceLine
+		wantTotals   []TrialBalanceTotal
+		wantBalanced bool
+	}{
+		{
+			name:         "empty ledger",
+			wantBalanced: true,
+		},
+		{
+			name: "balanced in every currency",
+			lines: []TrialBalanceLine{
+				{Account: "account1", Currency: usd, Debits: 100, Credits: 40},
+				{Account: "account1", Currency: jpy, Debits: 500},
+				{Account: "account2", Currency: usd, Debits: 40},
+				{Account: "external", Currency: jpy, Credits: 500},
+				{Account: "external", Currency: usd, Credits: 100},
+			},
+			wantTotals: []TrialBalanceTotal{
+				{Currency: usd, Debits: 140, Credits: 140},
+				{Currency: jpy, Debits: 500, Credits: 500},
+			},
+			wantBalanced: true,
+		},
+		{
+			name: "unbalanced currency",
+			lines: []TrialBalanceLine{
+				{Account: "account1", Currency: usd, Debits: 100},
+				{Account: "account1", Currency: jpy, Debits: 500},
+				{Account: "external", Currency: jpy, Credits: 500},
+				{Account: "external", Currency: usd, Credits: 90},
+			},
+			wantTotals: []TrialBalanceTotal{
+				{Currency: usd, Debits: 100, Credits: 90},
+				{Currency: jpy, Debits: 500, Credits: 500},
+			},
+			wantBalanced: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := NewTrialBalance(tt.lines)
+			assert.Equal(t, tt.lines, tb.Lines)
+			assert.Equal(t, tt.wantTotals, tb.Totals)
+			assert.Equal(t, tt.wantBalanced, tb.Balanced())
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+)
+
+// TransactionStatus is the lifecycle state of a transaction.
+type TransactionStatus string
+
+const (
+	// TransactionPending transactions reserve the funds they take from their
+	// accounts but do not move them until they are posted.
+	TransactionPending TransactionStatus = "pending"
+	// TransactionPosted transactions are final and count towards the
+	// balances of their accounts.
+	TransactionPosted TransactionStatus = "posted"
+	// TransactionVoided transactions were cancelled before being posted and
+	// never affect a balance.
+	TransactionVoided TransactionStatus = "voided"
+)
+
+// Transaction groups the entries booked together in the ledger.
+type Transaction struct {
+	ID     int64
+	Status TransactionStatus
+	// ReversalOf is the ID of the transaction this one reverses, zero for
+	// transactions that are not reversals.
+	ReversalOf int64
+	Entries    []Entry
+	CreatedAt  *time.Time
+}
+
+// Amount returns the sum of the debits of the transaction, which equals the
+// sum of its credits.
+func (t Transaction) Amount() int64 {
+	var amount int64
+	for _, e := range t.Entries {
+		if e.Direction == Debit {
+			amount += e.Amount
+		}
+	}
+
+	return amount
+}
+
+// Reversal returns the journal entry reversing amount of the transaction, or
+// all that is left of it when amount is zero, given the amount its previous
+// reversals already reversed. Every leg is booked in the opposite direction;
+// only transactions with two legs can be reversed partially.
+func (t Transaction) Reversal(amount, reversed int64) (*JournalEntry, error) {
+	if t.Status != TransactionPosted {
+		return nil, domain.ErrTransactionNotPosted
+	}
+
+	left := t.Amount() - reversed
+	if left <= 0 {
+		return nil, domain.ErrTransactionAlreadyReversed
+	}
+
+	if amount == 0 {
+		amount = left
+	}
+	if amount < 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+	if amount > left {
+		return nil, domain.ErrReversalExceedsTransaction
+	}
+	if amount != t.Amount() && len(t.Entries) != 2 {
+		return nil, domain.ErrPartialReversalLegs
+	}
+
+	entries := make([]Entry, 0, len(t.Entries))
+	for _, e := range t.Entries {
+		direction := Debit
+		if e.Direction == Debit {
+			direction = Credit
+		}
+
+		legAmount := e.Amount
+		if len(t.Entries) == 2 {
+			legAmount = amount
+		}
+
+		entries = append(entries, Entry{
+			Account:   e.Account,
+			Direction: direction,
+			Amount:    legAmount,
+			Currency:  e.Currency,
+		})
+	}
+
+	je, err := NewJournalEntry(entries...)
+	if err != nil {
+		return nil, err
+	}
+	je.ReversalOf = t.ID
+
+	return je, nil
+}
+
+// Post makes a pending transaction final.
+func (t *Transaction) Post() error {
+	return t.settle(TransactionPosted)
+}
+
+// Void cancels a pending transaction, making the funds it reserved available
+// again.
+func (t *Transaction) Void() error {
+	return t.settle(TransactionVoided)
+}
+
+func (t *Transaction) settle(status TransactionStatus) error {
+	if t.Status != TransactionPending {
+		return domain.ErrTransactionNotPending
+	}
+
+	t.Status = status
+	for i := range t.Entries {
+		t.Entries[i].Status = status
+	}
+
+	return nil
+}
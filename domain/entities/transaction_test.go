@@ -0,0 +1,177 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction_Settle(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     TransactionStatus
+		settle     func(*Transaction) error
+		wantStatus TransactionStatus
+		wantErr    error
+	}{
+		{
+			name:       "should post a pending transaction",
+			status:     TransactionPending,
+			settle:     (*Transaction).Post,
+			wantStatus: TransactionPosted,
+		},
+		{
+			name:       "should void a pending transaction",
+			status:     TransactionPending,
+			settle:     (*Transaction).Void,
+			wantStatus: TransactionVoided,
+		},
+		{
+			name:       "should fail to void a posted transaction",
+			status:     TransactionPosted,
+			settle:     (*Transaction).Void,
+			wantStatus: TransactionPosted,
+			wantErr:    domain.ErrTransactionNotPending,
+		},
+		{
+			name:       "should fail to post a voided transaction",
+			status:     TransactionVoided,
+			settle:     (*Transaction).Post,
+			wantStatus: TransactionVoided,
+			wantErr:    domain.ErrTransactionNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &Transaction{
+				ID:     1,
+				Status: tt.status,
+				Entries: []Entry{
+					{Account: "account1", Direction: Credit, Amount: 100, Status: tt.status},
+					{Account: "account2", Direction: Debit, Amount: 100, Status: tt.status},
+				},
+			}
+
+			err := tt.settle(tr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantStatus, tr.Status)
+			for _, e := range tr.Entries {
+				assert.Equal(t, tt.wantStatus, e.Status)
+			}
+		})
+	}
+}
+
+func TestTransaction_Reversal(t *testing.T) {
+	usd := Currency{Code: "USD", Exponent: 2}
+	deposit := Transaction{
+		ID:     7,
+		Status: TransactionPosted,
+		Entries: []Entry{
+			{Account: "external", Direction: Credit, Amount: 100, Currency: usd},
+			{Account: "account1", Direction: Debit, Amount: 100, Currency: usd},
+		},
+	}
+	payment := Transaction{
+		ID:     8,
+		Status: TransactionPosted,
+		Entries: []Entry{
+			{Account: "payer", Direction: Credit, Amount: 100, Currency: usd},
+			{Account: "merchant", Direction: Debit, Amount: 97, Currency: usd},
+			{Account: "fees", Direction: Debit, Amount: 3, Currency: usd},
+		},
+	}
+	pending := deposit
+	pending.Status = TransactionPending
+
+	tests := []struct {
+		name        string
+		transaction Transaction
+		amount      int64
+		reversed    int64
+		want        []Entry
+		wantErr     error
+	}{
+		{
+			name:        "should reverse the whole transaction",
+			transaction: deposit,
+			want: []Entry{
+				{Account: "external", Direction: Debit, Amount: 100, Currency: usd},
+				{Account: "account1", Direction: Credit, Amount: 100, Currency: usd},
+			},
+		},
+		{
+			name:        "should reverse part of a two-legged transaction",
+			transaction: deposit,
+			amount:      30,
+			want: []Entry{
+				{Account: "external", Direction: Debit, Amount: 30, Currency: usd},
+				{Account: "account1", Direction: Credit, Amount: 30, Currency: usd},
+			},
+		},
+		{
+			name:        "should reverse what is left after a partial reversal",
+			transaction: deposit,
+			reversed:    30,
+			want: []Entry{
+				{Account: "external", Direction: Debit, Amount: 70, Currency: usd},
+				{Account: "account1", Direction: Credit, Amount: 70, Currency: usd},
+			},
+		},
+		{
+			name:        "should reverse every leg of a transaction",
+			transaction: payment,
+			want: []Entry{
+				{Account: "payer", Direction: Debit, Amount: 100, Currency: usd},
+				{Account: "merchant", Direction: Credit, Amount: 97, Currency: usd},
+				{Account: "fees", Direction: Credit, Amount: 3, Currency: usd},
+			},
+		},
+		{
+			name:        "should fail to reverse a transaction twice",
+			transaction: deposit,
+			reversed:    100,
+			wantErr:     domain.ErrTransactionAlreadyReversed,
+		},
+		{
+			name:        "should fail to reverse more than is left",
+			transaction: deposit,
+			amount:      80,
+			reversed:    30,
+			wantErr:     domain.ErrReversalExceedsTransaction,
+		},
+		{
+			name:        "should fail to partially reverse a transaction with three legs",
+			transaction: payment,
+			amount:      50,
+			wantErr:     domain.ErrPartialReversalLegs,
+		},
+		{
+			name:        "should fail to reverse a pending transaction",
+			transaction: pending,
+			wantErr:     domain.ErrTransactionNotPosted,
+		},
+		{
+			name:        "should fail to reverse a negative amount",
+			transaction: deposit,
+			amount:      -5,
+			wantErr:     domain.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.transaction.Reversal(tt.amount, tt.reversed)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.want, got.Entries)
+			assert.Equal(t, tt.transaction.ID, got.ReversalOf)
+		})
+	}
+}
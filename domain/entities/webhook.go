@@ -0,0 +1,181 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+)
+
+// WebhookStatus is the lifecycle state of a webhook subscription.
+type WebhookStatus string
+
+const (
+	// WebhookActive subscriptions are notified of the transactions posted on
+	// their account.
+	WebhookActive WebhookStatus = "active"
+	// WebhookDisabled subscriptions were deleted; their deliveries are kept
+	// for the log but no longer attempted.
+	WebhookDisabled WebhookStatus = "disabled"
+)
+
+// Webhook is a subscription to the transactions posted on an account, pushed
+// to a partner's URL.
+type Webhook struct {
+	ID      int64
+	Account string
+	URL     string
+	// Secret signs the deliveries, so the partner can tell they come from
+	// the ledger.
+	Secret    string
+	Status    WebhookStatus
+	CreatedAt *time.Time
+}
+
+// NewWebhook creates a new active Webhook with account and URL validation. A
+// random secret is generated when secret is empty.
+func NewWebhook(account, rawURL, secret string) (*Webhook, error) {
+	if err := ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, domain.ErrInvalidWebhookURL
+	}
+
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	return &Webhook{
+		Account: account,
+		URL:     rawURL,
+		Secret:  secret,
+		Status:  WebhookActive,
+	}, nil
+}
+
+// SignWebhook returns the hex-encoded HMAC-SHA256, keyed with secret, of the
+// timestamp in Unix seconds, a dot and the body. Receivers recompute it to
+// authenticate a delivery and reject stale timestamps to prevent replays.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDeliveryStatus is the lifecycle state of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending deliveries are attempted until they succeed or
+	// run out of attempts.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded deliveries were acknowledged with a 2xx
+	// response.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryDead deliveries failed every attempt and are given up.
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+const (
+	// WebhookMaxAttempts is the number of attempts after which a failing
+	// delivery is dead.
+	WebhookMaxAttempts = 8
+	// webhookBaseBackoff is the delay before the first retry, doubled before
+	// each of the next ones.
+	webhookBaseBackoff = 30 * time.Second
+	// webhookMaxBackoff caps the delay between retries.
+	webhookMaxBackoff = time.Hour
+)
+
+// WebhookDelivery is the notification of an event to a webhook subscription.
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     int64
+	EventID       int64
+	EventType     EventType
+	TransactionID int64
+	// Account is the subscribed account the event touched.
+	Account string
+	// URL and Secret are the subscription's, and Payload the event's.
+	URL     string
+	Secret  string
+	Payload []byte
+
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      *time.Time
+}
+
+// Body returns the JSON document posted to the subscription: the delivery
+// and event IDs, the event type, the account and the event's payload as
+// transaction. It is the same for every attempt, so receivers can use id to
+// skip duplicates.
+func (d WebhookDelivery) Body() ([]byte, error) {
+	return json.Marshal(struct {
+		ID          int64           `json:"id"`
+		EventID     int64           `json:"event_id"`
+		Type        EventType       `json:"type"`
+		Account     string          `json:"account"`
+		Transaction json.RawMessage `json:"transaction"`
+	}{d.ID, d.EventID, d.EventType, d.Account, d.Payload})
+}
+
+// Succeed records a successful attempt at now.
+func (d *WebhookDelivery) Succeed(now time.Time, statusCode int) {
+	d.Attempts++
+	d.Status = WebhookDeliverySucceeded
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = &now
+}
+
+// Fail records a failed attempt at now, with the response status code, zero
+// if there was no response, and the error. The next attempt is scheduled with
+// an exponential backoff, unless the delivery ran out of attempts and is dead.
+func (d *WebhookDelivery) Fail(now time.Time, statusCode int, err error) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = err.Error()
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+
+	backoff := webhookBaseBackoff << (d.Attempts - 1)
+	if backoff > webhookMaxBackoff {
+		backoff = webhookMaxBackoff
+	}
+	d.NextAttemptAt = now.Add(backoff)
+}
+
+// WebhookDeliveryQuery selects a page of the deliveries of a webhook
+// subscription, newest first.
+type WebhookDeliveryQuery struct {
+	WebhookID int64
+
+	// Before, when set, continues the listing past the delivery with that ID.
+	Before int64
+
+	Limit int
+}
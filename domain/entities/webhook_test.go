@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		account string
+		url     string
+		secret  string
+		want    *Webhook
+		wantErr error
+	}{
+		{
+			name:    "should create an active webhook",
+			account: "account1",
+			url:     "https://partner.example/hooks/ledger",
+			secret:  "whsec",
+			want:    &Webhook{Account: "account1", URL: "https://partner.example/hooks/ledger", Secret: "whsec", Status: WebhookActive},
+		},
+		{
+			name:    "should fail on an invalid account",
+			account: "account 1",
+			url:     "https://partner.example/hooks/ledger",
+			wantErr: domain.ErrInvalidAccount,
+		},
+		{
+			name:    "should fail on a relative URL",
+			account: "account1",
+			url:     "/hooks/ledger",
+			wantErr: domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:    "should fail on a URL that is not http",
+			account: "account1",
+			url:     "ftp://partner.example/hooks",
+			wantErr: domain.ErrInvalidWebhookURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewWebhook(tt.account, tt.url, tt.secret)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("should generate a secret", func(t *testing.T) {
+		w1, err := NewWebhook("account1", "http://localhost:8080/hooks", "")
+		assert.NoError(t, err)
+		w2, err := NewWebhook("account1", "http://localhost:8080/hooks", "")
+		assert.NoError(t, err)
+
+		assert.Len(t, w1.Secret, 64)
+		assert.NotEqual(t, w1.Secret, w2.Secret)
+	})
+}
+
+func TestSignWebhook(t *testing.T) {
+	body := []byte(`{"id":1}`)
+
+	assert.Equal(t, "c466df187c04568e79e1c494f4bcb1a0645e065fc5f8b904aadcf18353cbb20a", SignWebhook("whsec", 1760000000, body))
+	assert.NotEqual(t, SignWebhook("whsec", 1760000000, body), SignWebhook("other", 1760000000, body))
+	assert.NotEqual(t, SignWebhook("whsec", 1760000000, body), SignWebhook("whsec", 1760000001, body))
+}
+
+func TestWebhookDelivery_Body(t *testing.T) {
+	d := WebhookDelivery{ID: 3, EventID: 9, EventType: EventTransactionPosted, Account: "jc", Payload: []byte(`{"ID":7}`)}
+
+	body, err := d.Body()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":3,"event_id":9,"type":"TransactionPosted","account":"jc","transaction":{"ID":7}}`, string(body))
+}
+
+func TestWebhookDelivery_Attempts(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	failure := errors.New("unexpected status 503")
+
+	t.Run("should back off exponentially and give up", func(t *testing.T) {
+		d := WebhookDelivery{Status: WebhookDeliveryPending}
+		wantBackoff := []time.Duration{
+			30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute,
+			8 * time.Minute, 16 * time.Minute, 32 * time.Minute,
+		}
+		for i, backoff := range wantBackoff {
+			d.Fail(now, 503, failure)
+			assert.Equal(t, i+1, d.Attempts)
+			assert.Equal(t, WebhookDeliveryPending, d.Status)
+			assert.Equal(t, now.Add(backoff), d.NextAttemptAt)
+		}
+
+		d.Fail(now, 0, failure)
+		assert.Equal(t, WebhookMaxAttempts, d.Attempts)
+		assert.Equal(t, WebhookDeliveryDead, d.Status)
+		assert.Equal(t, 0, d.LastStatusCode)
+		assert.Equal(t, "unexpected status 503", d.LastError)
+	})
+
+	t.Run("should succeed after a failure", func(t *testing.T) {
+		d := WebhookDelivery{Status: WebhookDeliveryPending}
+		d.Fail(now, 500, failure)
+		d.Succeed(now.Add(time.Minute), 204)
+
+		assert.Equal(t, 2, d.Attempts)
+		assert.Equal(t, WebhookDeliverySucceeded, d.Status)
+		assert.Equal(t, 204, d.LastStatusCode)
+		assert.Empty(t, d.LastError)
+		assert.Equal(t, now.Add(time.Minute), *d.DeliveredAt)
+	})
+}
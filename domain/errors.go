@@ -11,4 +11,124 @@ var (
 
 	// ErrAmountsNotMatch is an error for amounts not matching.
 	ErrAmountsNotMatch = errors.New("debit and credit amounts must match")
+
+	// ErrCurrenciesNotMatch is an error for entries in different currencies.
+	ErrCurrenciesNotMatch = errors.New("all entries must be in the same currency")
+
+	// ErrInvalidCurrency is an error for unknown ISO 4217 currency codes.
+	ErrInvalidCurrency = errors.New("invalid currency")
+
+	// ErrUnbalancedJournalEntry is an error for journal entries whose debits
+	// and credits do not sum to the same amount.
+	ErrUnbalancedJournalEntry = errors.New("journal entry debits and credits must balance")
+
+	// ErrJournalEntryLegs is an error for journal entries missing a debit or a credit.
+	ErrJournalEntryLegs = errors.New("journal entry needs at least one debit and one credit")
+
+	// ErrIdempotencyKeyReused is an error for an idempotency key replayed
+	// with a different request.
+	ErrIdempotencyKeyReused = errors.New("idempotency key already used with a different request")
+
+	// ErrInvalidAmount is an error for zero or negative amounts.
+	ErrInvalidAmount = errors.New("amount must be positive")
+
+	// ErrEmptyAccount is an error for a missing account identifier.
+	ErrEmptyAccount = errors.New("account must not be empty")
+
+	// ErrInvalidAccount is an error for malformed account identifiers.
+	ErrInvalidAccount = errors.New("invalid account format")
+
+	// ErrSelfTransfer is an error for transfers whose source and destination
+	// are the same account.
+	ErrSelfTransfer = errors.New("cannot transfer to the same account")
+
+	// ErrInvalidCursor is an error for malformed pagination cursors.
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrInvalidPageLimit is an error for page sizes out of the allowed range.
+	ErrInvalidPageLimit = errors.New("invalid page limit")
+
+	// ErrAccountNotFound is an error for an account that is not registered.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrAccountAlreadyExists is an error for registering an account twice.
+	ErrAccountAlreadyExists = errors.New("account already exists")
+
+	// ErrAccountFrozen is an error for postings on a frozen account.
+	ErrAccountFrozen = errors.New("account is frozen")
+
+	// ErrAccountClosed is an error for postings on a closed account.
+	ErrAccountClosed = errors.New("account is closed")
+
+	// ErrInvalidAccountStatus is an error for unknown account statuses.
+	ErrInvalidAccountStatus = errors.New("invalid account status")
+
+	// ErrInvalidAccountType is an error for unknown account types.
+	ErrInvalidAccountType = errors.New("invalid account type")
+
+	// ErrInvalidOverdraftLimit is an error for negative overdraft limits other
+	// than the unlimited one.
+	ErrInvalidOverdraftLimit = errors.New("invalid overdraft limit")
+
+	// ErrParentNotFound is an error for a parent account that is not
+	// registered.
+	ErrParentNotFound = errors.New("parent account not found")
+
+	// ErrInvalidParent is an error for a parent account that cannot hold the
+	// account, such as one of a different type.
+	ErrInvalidParent = errors.New("invalid parent account")
+
+	// ErrInvalidStatusTransition is an error for status changes the account
+	// lifecycle does not allow, such as reopening a closed account.
+	ErrInvalidStatusTransition = errors.New("invalid account status transition")
+
+	// ErrHoldNotFound is an error for a hold that does not exist.
+	ErrHoldNotFound = errors.New("hold not found")
+
+	// ErrHoldNotActive is an error for capturing or releasing a hold that was
+	// already settled.
+	ErrHoldNotActive = errors.New("hold is not active")
+
+	// ErrHoldExpired is an error for capturing or releasing a hold past its
+	// expiry.
+	ErrHoldExpired = errors.New("hold expired")
+
+	// ErrInvalidHoldExpiry is an error for holds expiring before they are
+	// created.
+	ErrInvalidHoldExpiry = errors.New("hold expiry must be in the future")
+
+	// ErrCaptureExceedsHold is an error for capturing more than a hold
+	// reserves.
+	ErrCaptureExceedsHold = errors.New("capture exceeds held amount")
+
+	// ErrTransactionNotFound is an error for a transaction that does not exist.
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	// ErrTransactionNotPending is an error for posting or voiding a
+	// transaction that was already settled.
+	ErrTransactionNotPending = errors.New("transaction is not pending")
+
+	// ErrTransactionNotPosted is an error for reversing a transaction that is
+	// pending or voided.
+	ErrTransactionNotPosted = errors.New("transaction is not posted")
+
+	// ErrTransactionAlreadyReversed is an error for reversing a transaction
+	// whose whole amount was already reversed.
+	ErrTransactionAlreadyReversed = errors.New("transaction already reversed")
+
+	// ErrReversalExceedsTransaction is an error for reversing more than what
+	// is left of a transaction.
+	ErrReversalExceedsTransaction = errors.New("reversal exceeds the unreversed amount")
+
+	// ErrPartialReversalLegs is an error for partially reversing a transaction
+	// with more than two legs, which cannot be split unambiguously.
+	ErrPartialReversalLegs = errors.New("only transactions with two legs can be partially reversed")
+
+	// ErrWebhookNotFound is an error for a webhook subscription that does not
+	// exist.
+	ErrWebhookNotFound = errors.New("webhook not found")
+
+	// ErrInvalidWebhookURL is an error for webhook URLs that are not absolute
+	// http or https URLs.
+	ErrInvalidWebhookURL = errors.New("webhook URL must be an absolute http or https URL")
 )
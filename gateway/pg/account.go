@@ -2,90 +2,1223 @@ package pg
 
 import (
 	"context"
-	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/entities"
 )
 
+// uniqueViolation is the Postgres error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 type AccountPgxRepository struct {
-	conn *pgx.Conn
+	pool *pgxpool.Pool
+}
+
+func NewAccountPgxRepository(pool *pgxpool.Pool) *AccountPgxRepository {
+	return &AccountPgxRepository{pool}
+}
+
+// Transfer books both entries without checking the funds of either account
+// and returns the ID of the transaction grouping them.
+func (r *AccountPgxRepository) Transfer(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	return r.Journal(ctx, entries.JournalEntry(), nil)
+}
+
+// TransferWithFundsCheck books both entries only if both accounts stay within
+// their overdraft limit.
+func (r *AccountPgxRepository) TransferWithFundsCheck(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	je := entries.JournalEntry()
+
+	return r.Journal(ctx, je, postingAccounts(je))
+}
+
+// PendingTransfer books both entries in a pending transaction only if the
+// available balances of both accounts stay within their overdraft limit.
+func (r *AccountPgxRepository) PendingTransfer(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	je := entries.JournalEntry()
+	je.Pending = true
+
+	return r.Journal(ctx, je, postingAccounts(je))
+}
+
+// Journal books all legs of the journal entry in a single transaction and
+// returns its ID. It fails with domain.ErrInsufficientFunds if the entry
+// decreases the available balance of any of the checked accounts, on its
+// normal side, beyond the account's overdraft limit. Pending journal entries
+// only update the stored balances once they are posted.
+//
+// If the journal entry carries an idempotency key that was already used, the
+// original transaction ID is returned without booking anything, or
+// domain.ErrIdempotencyKeyReused if the legs differ from the original ones.
+func (r *AccountPgxRepository) Journal(ctx context.Context, je entities.JournalEntry, checked []string) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	transactionID, err := book(ctx, tx, je, checked)
+	if err != nil {
+		if errors.Is(err, errIdempotencyKeyRace) {
+			// A request with different legs raced us to the key.
+			tx.Rollback(ctx)
+			return replay(ctx, r.pool, je.IdempotencyKey, je.Fingerprint())
+		}
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return transactionID, nil
+}
+
+// errIdempotencyKeyRace is returned by book when another transaction stored
+// the idempotency key first. The transaction must be rolled back before the
+// winner can be replayed.
+var errIdempotencyKeyRace = errors.New("idempotency key booked concurrently")
+
+// book books the journal entry in tx as described by Journal. The check and
+// the inserts run while the account rows are locked, so concurrent postings
+// cannot overdraw an account. Every entry is stored with its hash, chaining it
+// to the entry booked before it, and posted transactions are announced in the
+// outbox. The entries are inserted last, once everything else is done, since
+// the hash chain lock is held from then on until the transaction commits.
+//
+// Of the checked accounts, only the ones whose balance decreases on their
+// normal side are checked, so callers checking a posting pass all of its
+// accounts, from postingAccounts, whatever their types.
+func book(ctx context.Context, tx pgx.Tx, je entities.JournalEntry, checked []string) (int64, error) {
+	transactionTpl := `
+		INSERT INTO transactions (idempotency_key, request_hash, status, settled_at, reverses)
+		VALUES (NULLIF($1, ''), NULLIF($2, ''), $3, CASE WHEN $3 = 'posted' THEN NOW() END, NULLIF($4, 0))
+		RETURNING id
+	`
+	queryTpl := `
+		INSERT INTO entries (transaction_id, account, direction, amount, currency, created_at, hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	accounts := make([]string, 0, len(je.Entries))
+	for _, e := range je.Entries {
+		accounts = append(accounts, e.Account)
+	}
+
+	locked, err := lockAccounts(ctx, tx, accounts...)
+	if err != nil {
+		return 0, err
+	}
+
+	// Retries of the same request lock the same accounts, so by now the
+	// original has either committed or rolled back.
+	var fingerprint string
+	if je.IdempotencyKey != "" {
+		fingerprint = je.Fingerprint()
+
+		transactionID, err := replay(ctx, tx, je.IdempotencyKey, fingerprint)
+		if err == nil || !errors.Is(err, pgx.ErrNoRows) {
+			return transactionID, err
+		}
+	}
+
+	byID := make(map[string]entities.Account, len(locked))
+	for _, a := range locked {
+		if err := a.CanPost(); err != nil {
+			return 0, fmt.Errorf("%w: %s", err, a.ID)
+		}
+		byID[a.ID] = a
+	}
+
+	net := je.Net()
+	for _, account := range checked {
+		a := byID[account]
+		change := a.Type.Normalize(net[account])
+		if change >= 0 {
+			continue
+		}
+
+		available, err := available(ctx, tx, a, je.Currency().Code)
+		if err != nil {
+			return 0, err
+		}
+
+		if !a.Covers(available + change) {
+			return 0, domain.ErrInsufficientFunds
+		}
+	}
+
+	status := entities.TransactionPosted
+	if je.Pending {
+		status = entities.TransactionPending
+	}
+
+	var transactionID int64
+	err = tx.QueryRow(ctx, transactionTpl, je.IdempotencyKey, fingerprint, status, je.ReversalOf).Scan(&transactionID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return 0, errIdempotencyKeyRace
+		}
+		return 0, err
+	}
+
+	if !je.Pending {
+		err = updateBalances(ctx, tx, je)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = notifyEntries(ctx, tx, accounts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Appending to the hash chain serializes every booking of the ledger
+	// until it commits, so it is left for last.
+	prev, createdAt, err := chainHead(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	t := entities.Transaction{
+		ID:         transactionID,
+		Status:     status,
+		ReversalOf: je.ReversalOf,
+		Entries:    make([]entities.Entry, 0, len(je.Entries)),
+		CreatedAt:  &createdAt,
+	}
+	for _, e := range je.Entries {
+		e.TransactionID = transactionID
+		e.Status = status
+		e.ReversalOf = je.ReversalOf
+		e.CreatedAt = &createdAt
+		prev = e.Hash(prev)
+
+		err = tx.QueryRow(ctx, queryTpl, transactionID, e.Account, e.Direction, e.Amount, e.Currency.Code, createdAt, prev).Scan(&e.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		t.Entries = append(t.Entries, e)
+	}
+
+	if !je.Pending {
+		// The event carries the IDs of the entries.
+		err = enqueueTransactionPosted(ctx, tx, t)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return transactionID, nil
 }
 
-func NewAccountPgxRepository(conn *pgx.Conn) *AccountPgxRepository {
-	return &AccountPgxRepository{conn}
+// postingAccounts returns the accounts of the journal entry, sorted, for book
+// to check.
+func postingAccounts(je entities.JournalEntry) []string {
+	accounts := make([]string, 0, len(je.Entries))
+	for account := range je.Net() {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	return accounts
 }
 
-func (r *AccountPgxRepository) Transfer(ctx context.Context, entries entities.DoubleEntry) error {
+// updateBalances applies the net effect of the journal entry to the stored
+// balances of its accounts. Stored balances are debits minus credits whatever
+// the account type. It must run in the transaction booking the
+// entries, after their accounts were locked.
+func updateBalances(ctx context.Context, tx pgx.Tx, je entities.JournalEntry) error {
 	queryTpl := `
-		INSERT INTO entries (account, direction, amount)
+		INSERT INTO account_balances (account, currency, balance)
 		VALUES ($1, $2, $3)
+		ON CONFLICT (account, currency) DO UPDATE
+		SET balance = account_balances.balance + EXCLUDED.balance, updated_at = NOW()
+	`
+
+	net := je.Net()
+	accounts := make([]string, 0, len(net))
+	for account := range net {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	for _, account := range accounts {
+		_, err := tx.Exec(ctx, queryTpl, account, je.Currency().Code, net[account])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replay returns the ID of the transaction booked with the idempotency key, or
+// domain.ErrIdempotencyKeyReused if it was booked with a different
+// fingerprint. It returns pgx.ErrNoRows if the key was never used.
+func replay(ctx context.Context, q querier, idempotencyKey, fingerprint string) (int64, error) {
+	queryTpl := `
+		SELECT id, request_hash
+		FROM transactions
+		WHERE idempotency_key = $1;
 	`
 
-	tx, err := r.conn.Begin(ctx)
+	var transactionID int64
+	var requestHash string
+	err := q.QueryRow(ctx, queryTpl, idempotencyKey).Scan(&transactionID, &requestHash)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	if requestHash != fingerprint {
+		return 0, domain.ErrIdempotencyKeyReused
+	}
+
+	return transactionID, nil
+}
+
+// lockAccounts takes a row lock on each account until the end of tx and
+// returns the locked accounts. Locks are taken in a stable order to avoid
+// deadlocks between transfers running in opposite directions. It fails with
+// domain.ErrAccountNotFound if an account is not registered.
+func lockAccounts(ctx context.Context, tx pgx.Tx, accounts ...string) ([]entities.Account, error) {
+	sorted := append([]string(nil), accounts...)
+	sort.Strings(sorted)
+
+	locked := make([]entities.Account, 0, len(sorted))
+	for i, account := range sorted {
+		if i > 0 && sorted[i-1] == account {
+			continue
+		}
+
+		a, err := lockAccount(ctx, tx, account)
+		if err != nil {
+			return nil, err
+		}
+
+		locked = append(locked, *a)
+	}
+
+	return locked, nil
+}
+
+// lockAccount takes a row lock on the account until the end of tx and returns
+// it, or domain.ErrAccountNotFound if it is not registered.
+func lockAccount(ctx context.Context, tx pgx.Tx, id string) (*entities.Account, error) {
+	queryTpl := `
+		SELECT id, owner, COALESCE(parent, ''), type, status, overdraft_limit, created_at
+		FROM accounts
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	a, err := scanAccount(tx.QueryRow(ctx, queryTpl, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrAccountNotFound, id)
+		}
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// CreateAccount registers an account and returns it with its creation time.
+// A parent account is locked while the child is attached to it, so its type
+// cannot change underneath.
+func (r *AccountPgxRepository) CreateAccount(ctx context.Context, account entities.Account) (*entities.Account, error) {
+	queryTpl := `
+		INSERT INTO accounts (id, owner, parent, type, status, overdraft_limit)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)
+		RETURNING created_at
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
 	}
 	defer tx.Rollback(ctx)
 
-	_, err = tx.Exec(ctx, queryTpl, entries.Credit.Account, entries.Credit.Direction, entries.Credit.Amount)
+	if account.Parent != "" {
+		parent, err := lockAccount(ctx, tx, account.Parent)
+		if err != nil {
+			if errors.Is(err, domain.ErrAccountNotFound) {
+				return nil, fmt.Errorf("%w: %s", domain.ErrParentNotFound, account.Parent)
+			}
+			return nil, err
+		}
+
+		err = account.SetParent(*parent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.QueryRow(ctx, queryTpl, account.ID, account.Owner, account.Parent, account.Type, account.Status, account.OverdraftLimit).Scan(&account.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, domain.ErrAccountAlreadyExists
+		}
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// Account returns the registered account with the given ID.
+func (r *AccountPgxRepository) Account(ctx context.Context, id string) (*entities.Account, error) {
+	queryTpl := `
+		SELECT id, owner, COALESCE(parent, ''), type, status, overdraft_limit, created_at
+		FROM accounts
+		WHERE id = $1;
+	`
+
+	a, err := scanAccount(r.pool.QueryRow(ctx, queryTpl, id))
 	if err != nil {
-		return err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAccountNotFound
+		}
+		return nil, err
 	}
 
-	_, err = tx.Exec(ctx, queryTpl, entries.Debit.Account, entries.Debit.Direction, entries.Debit.Amount)
+	return a, nil
+}
+
+// Accounts returns up to query.Limit registered accounts matching the query,
+// ordered by ID.
+func (r *AccountPgxRepository) Accounts(ctx context.Context, query entities.AccountsQuery) ([]entities.Account, error) {
+	queryTpl := `
+		SELECT id, owner, COALESCE(parent, ''), type, status, overdraft_limit, created_at
+		FROM accounts
+		WHERE ($1 = '' OR owner = $1)
+			AND ($2 = '' OR parent = $2)
+			AND ($3 = '' OR type = $3)
+			AND ($4 = '' OR status = $4)
+			AND id > $5
+		ORDER BY id
+		LIMIT $6;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl, query.Owner, query.Parent, string(query.Type), string(query.Status), query.After, query.Limit)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := []entities.Account{}
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, *a)
+	}
+
+	return accounts, rows.Err()
+}
+
+// UpdateAccount locks the account, applies update to it and stores the
+// result. Postings on the account wait for the update to commit.
+func (r *AccountPgxRepository) UpdateAccount(ctx context.Context, id string, update func(*entities.Account) error) (*entities.Account, error) {
+	queryTpl := `
+		UPDATE accounts
+		SET owner = $2, status = $3
+		WHERE id = $1
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	a, err := lockAccount(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = update(a)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, queryTpl, a.ID, a.Owner, a.Status)
+	if err != nil {
+		return nil, err
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return a, nil
 }
 
-func (r *AccountPgxRepository) Balance(ctx context.Context, account string) (int64, error) {
+// SetOverdraftLimit locks the account, changes its overdraft limit and
+// records the change in the audit trail, in a single transaction.
+func (r *AccountPgxRepository) SetOverdraftLimit(ctx context.Context, change entities.OverdraftLimitChange) (*entities.Account, error) {
+	updateTpl := `
+		UPDATE accounts
+		SET overdraft_limit = $2
+		WHERE id = $1
+	`
+	auditTpl := `
+		INSERT INTO account_overdraft_limit_changes (account, old_limit, new_limit, reason)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	a, err := lockAccount(ctx, tx, change.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	change.OldLimit = a.OverdraftLimit
+	err = a.SetOverdraftLimit(change.NewLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, updateTpl, a.ID, a.OverdraftLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, auditTpl, change.Account, change.OldLimit, change.NewLimit, change.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// OverdraftLimitChanges returns the overdraft limit changes of the account,
+// newest first.
+func (r *AccountPgxRepository) OverdraftLimitChanges(ctx context.Context, account string) ([]entities.OverdraftLimitChange, error) {
 	queryTpl := `
+		SELECT id, account, old_limit, new_limit, reason, created_at
+		FROM account_overdraft_limit_changes
+		WHERE account = $1
+		ORDER BY id DESC;
+	`
+
+	if _, err := r.Account(ctx, account); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, queryTpl, account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []entities.OverdraftLimitChange{}
+	for rows.Next() {
+		var c entities.OverdraftLimitChange
+		err := rows.Scan(&c.ID, &c.Account, &c.OldLimit, &c.NewLimit, &c.Reason, &c.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+func scanAccount(row pgx.Row) (*entities.Account, error) {
+	var a entities.Account
+	err := row.Scan(&a.ID, &a.Owner, &a.Parent, &a.Type, &a.Status, &a.OverdraftLimit, &a.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// Balance returns the posted balance of the account in each currency it holds,
+// ordered by currency code, on the normal side of the account's type, along
+// with the part of it not reserved by active holds and pending transactions.
+// The current balance is read from the stored balances; when asOf is set, the
+// entries posted up to and including that time are summed instead, and the
+// holds and transactions pending at that time are subtracted. It fails with
+// domain.ErrAccountNotFound unless the account is registered.
+func (r *AccountPgxRepository) Balance(ctx context.Context, account string, asOf *time.Time) ([]entities.Balance, error) {
+	currentTpl := `
+		WITH posted AS (
+			SELECT currency, balance
+			FROM account_balances
+			WHERE account = $1
+		), held AS (
+			SELECT currency, SUM(amount) AS amount
+			FROM holds
+			WHERE account = $1 AND settled_at IS NULL AND expires_at > NOW()
+			GROUP BY currency
+		), pending AS (
+			SELECT
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) AS debits,
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS credits
+			FROM entries e
+			JOIN transactions t ON t.id = e.transaction_id
+			WHERE e.account = $1 AND t.status = 'pending'
+			GROUP BY e.currency
+		)
+		SELECT
+			COALESCE(p.currency, h.currency, d.currency),
+			COALESCE(p.balance, 0),
+			COALESCE(h.amount, 0),
+			COALESCE(d.debits, 0),
+			COALESCE(d.credits, 0),
+			a.type
+		FROM posted p
+		FULL OUTER JOIN held h ON h.currency = p.currency
+		FULL OUTER JOIN pending d ON d.currency = COALESCE(p.currency, h.currency)
+		JOIN accounts a ON a.id = $1
+		ORDER BY 1;
+	`
+	asOfTpl := `
+		WITH posted AS (
+			SELECT
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) -
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS balance
+			FROM entries e
+			LEFT JOIN transactions t ON t.id = e.transaction_id
+			WHERE e.account = $1 AND e.created_at <= $2
+				AND (t.id IS NULL OR (t.status = 'posted' AND t.settled_at <= $2))
+			GROUP BY e.currency
+		), held AS (
+			SELECT currency, SUM(amount) AS amount
+			FROM holds
+			WHERE account = $1 AND created_at <= $2 AND expires_at > $2
+				AND (settled_at IS NULL OR settled_at > $2)
+			GROUP BY currency
+		), pending AS (
+			SELECT
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) AS debits,
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS credits
+			FROM entries e
+			JOIN transactions t ON t.id = e.transaction_id
+			WHERE e.account = $1 AND t.created_at <= $2 AND (t.settled_at IS NULL OR t.settled_at > $2)
+			GROUP BY e.currency
+		)
+		SELECT
+			COALESCE(p.currency, h.currency, d.currency),
+			COALESCE(p.balance, 0),
+			COALESCE(h.amount, 0),
+			COALESCE(d.debits, 0),
+			COALESCE(d.credits, 0),
+			a.type
+		FROM posted p
+		FULL OUTER JOIN held h ON h.currency = p.currency
+		FULL OUTER JOIN pending d ON d.currency = COALESCE(p.currency, h.currency)
+		JOIN accounts a ON a.id = $1
+		ORDER BY 1;
+	`
+
+	if _, err := r.Account(ctx, account); err != nil {
+		return nil, err
+	}
+
+	var rows pgx.Rows
+	var err error
+	if asOf == nil {
+		rows, err = r.pool.Query(ctx, currentTpl, account)
+	} else {
+		rows, err = r.pool.Query(ctx, asOfTpl, account, asOf.UTC())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBalances(rows)
+}
+
+// SubtreeBalance returns the balance of the account and all of its
+// descendants like Balance does for a single account. The subtree is resolved
+// and summed in a single query, once the root is known to be registered.
+func (r *AccountPgxRepository) SubtreeBalance(ctx context.Context, account string, asOf *time.Time) ([]entities.Balance, error) {
+	currentTpl := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM accounts WHERE id = $1
+			UNION ALL
+			SELECT a.id FROM accounts a JOIN subtree s ON a.parent = s.id
+		), posted AS (
+			SELECT currency, SUM(balance) AS balance
+			FROM account_balances
+			WHERE account IN (SELECT id FROM subtree)
+			GROUP BY currency
+		), held AS (
+			SELECT currency, SUM(amount) AS amount
+			FROM holds
+			WHERE account IN (SELECT id FROM subtree) AND settled_at IS NULL AND expires_at > NOW()
+			GROUP BY currency
+		), pending AS (
+			SELECT
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) AS debits,
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS credits
+			FROM entries e
+			JOIN transactions t ON t.id = e.transaction_id
+			WHERE e.account IN (SELECT id FROM subtree) AND t.status = 'pending'
+			GROUP BY e.currency
+		)
+		SELECT
+			COALESCE(p.currency, h.currency, d.currency),
+			COALESCE(p.balance, 0),
+			COALESCE(h.amount, 0),
+			COALESCE(d.debits, 0),
+			COALESCE(d.credits, 0),
+			a.type
+		FROM posted p
+		FULL OUTER JOIN held h ON h.currency = p.currency
+		FULL OUTER JOIN pending d ON d.currency = COALESCE(p.currency, h.currency)
+		JOIN accounts a ON a.id = $1
+		ORDER BY 1;
+	`
+	asOfTpl := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM accounts WHERE id = $1
+			UNION ALL
+			SELECT a.id FROM accounts a JOIN subtree s ON a.parent = s.id
+		), posted AS (
+			SELECT
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) -
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS balance
+			FROM entries e
+			LEFT JOIN transactions t ON t.id = e.transaction_id
+			WHERE e.account IN (SELECT id FROM subtree) AND e.created_at <= $2
+				AND (t.id IS NULL OR (t.status = 'posted' AND t.settled_at <= $2))
+			GROUP BY e.currency
+		), held AS (
+			SELECT currency, SUM(amount) AS amount
+			FROM holds
+			WHERE account IN (SELECT id FROM subtree) AND created_at <= $2 AND expires_at > $2
+				AND (settled_at IS NULL OR settled_at > $2)
+			GROUP BY currency
+		), pending AS (
+			SELECT
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) AS debits,
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS credits
+			FROM entries e
+			JOIN transactions t ON t.id = e.transaction_id
+			WHERE e.account IN (SELECT id FROM subtree) AND t.created_at <= $2 AND (t.settled_at IS NULL OR t.settled_at > $2)
+			GROUP BY e.currency
+		)
+		SELECT
+			COALESCE(p.currency, h.currency, d.currency),
+			COALESCE(p.balance, 0),
+			COALESCE(h.amount, 0),
+			COALESCE(d.debits, 0),
+			COALESCE(d.credits, 0),
+			a.type
+		FROM posted p
+		FULL OUTER JOIN held h ON h.currency = p.currency
+		FULL OUTER JOIN pending d ON d.currency = COALESCE(p.currency, h.currency)
+		JOIN accounts a ON a.id = $1
+		ORDER BY 1;
+	`
+
+	if _, err := r.Account(ctx, account); err != nil {
+		return nil, err
+	}
+
+	var rows pgx.Rows
+	var err error
+	if asOf == nil {
+		rows, err = r.pool.Query(ctx, currentTpl, account)
+	} else {
+		rows, err = r.pool.Query(ctx, asOfTpl, account, asOf.UTC())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBalances(rows)
+}
+
+// scanBalances reads rows of currency, posted debits minus credits, held
+// amount, pending debits, pending credits and account type into balances on
+// the normal side of the type, and closes rows.
+func scanBalances(rows pgx.Rows) ([]entities.Balance, error) {
+	defer rows.Close()
+
+	balances := []entities.Balance{}
+	for rows.Next() {
+		var code string
+		var held, pendingDebits, pendingCredits int64
+		var accountType entities.AccountType
+		var b entities.Balance
+		err := rows.Scan(&code, &b.Amount, &held, &pendingDebits, &pendingCredits, &accountType)
+		if err != nil {
+			return nil, err
+		}
+		b.Amount = accountType.Normalize(b.Amount)
+		b.Available = b.Amount - held - pendingOutflow(accountType, pendingDebits, pendingCredits)
+
+		b.Currency, err = entities.NewCurrency(code)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, b)
+	}
+
+	return balances, rows.Err()
+}
+
+// available returns the balance of the locked account in a single currency,
+// on its normal side, minus the funds reserved by its active holds and by its
+// pending transactions.
+func available(ctx context.Context, q querier, a entities.Account, currency string) (int64, error) {
+	heldTpl := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM holds
+		WHERE account = $1 AND currency = $2 AND settled_at IS NULL AND expires_at > NOW();
+	`
+	pendingTpl := `
 		SELECT
-			SUM(CASE WHEN direction = 'debit' THEN amount ELSE 0 END) -
-			SUM(CASE WHEN direction = 'credit' THEN amount ELSE 0 END) AS balance
-		FROM entries
-		WHERE account = $1;
+			COALESCE(SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END), 0)
+		FROM entries e
+		JOIN transactions t ON t.id = e.transaction_id
+		WHERE e.account = $1 AND e.currency = $2 AND t.status = 'pending';
 	`
 
-	var balance sql.NullInt64
-	err := r.conn.QueryRow(ctx, queryTpl, account).Scan(&balance)
+	balance, err := balance(ctx, q, a.ID, currency)
 	if err != nil {
 		return 0, err
 	}
 
-	if !balance.Valid {
-		return 0, nil
+	var held int64
+	err = q.QueryRow(ctx, heldTpl, a.ID, currency).Scan(&held)
+	if err != nil {
+		return 0, err
 	}
 
-	return balance.Int64, nil
+	var pendingDebits, pendingCredits int64
+	err = q.QueryRow(ctx, pendingTpl, a.ID, currency).Scan(&pendingDebits, &pendingCredits)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.Type.Normalize(balance) - held - pendingOutflow(a.Type, pendingDebits, pendingCredits), nil
 }
 
-func (r *AccountPgxRepository) TransfersHistory(ctx context.Context, account string) ([]entities.Entry, error) {
+// pendingOutflow returns the pending entries that decrease the balance of an
+// account of the type: the credits of debit-normal accounts and the debits of
+// credit-normal ones. Pending entries increasing it are not available until
+// they are posted.
+func pendingOutflow(accountType entities.AccountType, debits, credits int64) int64 {
+	if accountType.NormalBalance() == entities.Debit {
+		return credits
+	}
+
+	return debits
+}
+
+// balance returns the stored balance of the account in a single currency.
+func balance(ctx context.Context, q querier, account, currency string) (int64, error) {
 	queryTpl := `
-		SELECT id, account, direction, amount, created_at
-		FROM entries
-		WHERE account = $1
-		ORDER BY created_at DESC;
+		SELECT balance
+		FROM account_balances
+		WHERE account = $1 AND currency = $2;
+	`
+
+	var balance int64
+	err := q.QueryRow(ctx, queryTpl, account, currency).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// RebuildBalances recomputes every stored balance from the posted entries and
+// returns the ones that did not match, ordered by account and currency.
+// Drifts are reported as stored, in debits minus credits.
+// Bookings wait for the rebuild to finish, so it sees a consistent ledger.
+func (r *AccountPgxRepository) RebuildBalances(ctx context.Context) ([]entities.BalanceDrift, error) {
+	lockTpl := `LOCK TABLE account_balances IN EXCLUSIVE MODE`
+	driftTpl := `
+		WITH computed AS (
+			SELECT
+				e.account,
+				e.currency,
+				SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) -
+				SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END) AS balance
+			FROM entries e
+			LEFT JOIN transactions t ON t.id = e.transaction_id
+			WHERE t.id IS NULL OR t.status = 'posted'
+			GROUP BY e.account, e.currency
+		)
+		SELECT
+			COALESCE(c.account, b.account),
+			COALESCE(c.currency, b.currency),
+			COALESCE(b.balance, 0),
+			COALESCE(c.balance, 0)
+		FROM computed c
+		FULL OUTER JOIN account_balances b ON b.account = c.account AND b.currency = c.currency
+		WHERE c.balance IS DISTINCT FROM b.balance
+		ORDER BY 1, 2;
+	`
+	upsertTpl := `
+		INSERT INTO account_balances (account, currency, balance)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (account, currency) DO UPDATE
+		SET balance = EXCLUDED.balance, updated_at = NOW()
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, lockTpl)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, driftTpl)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := []entities.BalanceDrift{}
+	for rows.Next() {
+		var d entities.BalanceDrift
+		var currency string
+		err := rows.Scan(&d.Account, &currency, &d.Stored, &d.Computed)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		d.Currency, err = entities.NewCurrency(currency)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		drift = append(drift, d)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, d := range drift {
+		_, err = tx.Exec(ctx, upsertTpl, d.Account, d.Currency.Code, d.Computed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return drift, nil
+}
+
+// TransfersHistory returns up to query.Limit entries of the account matching
+// the query, newest first. Pages are read by keyset on (created_at, id), so
+// deep pages cost the same as the first one.
+func (r *AccountPgxRepository) TransfersHistory(ctx context.Context, query entities.HistoryQuery) ([]entities.Entry, error) {
+	args := []any{query.Account}
+	where := []string{"e.account = $1"}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if query.From != nil {
+		where = append(where, "e.created_at >= "+arg(query.From.UTC()))
+	}
+	if query.To != nil {
+		where = append(where, "e.created_at < "+arg(query.To.UTC()))
+	}
+	if query.Direction != "" {
+		where = append(where, "e.direction = "+arg(query.Direction))
+	}
+	if query.MinAmount > 0 {
+		where = append(where, "e.amount >= "+arg(query.MinAmount))
+	}
+	if query.MaxAmount > 0 {
+		where = append(where, "e.amount <= "+arg(query.MaxAmount))
+	}
+	if query.After != nil {
+		where = append(where, "(e.created_at, e.id) < ("+arg(query.After.CreatedAt)+", "+arg(query.After.ID)+")")
+	}
+
+	queryTpl := `
+		SELECT e.id, COALESCE(e.transaction_id, 0), e.account, e.direction, e.amount, e.currency, COALESCE(t.status, 'posted'), COALESCE(t.reverses, 0), e.created_at
+		FROM entries e
+		LEFT JOIN transactions t ON t.id = e.transaction_id
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT ` + arg(query.Limit) + `;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanEntries(rows)
+}
+
+// Transaction returns the transaction with the given ID and all of its entries.
+func (r *AccountPgxRepository) Transaction(ctx context.Context, id int64) (*entities.Transaction, error) {
+	transactionTpl := `
+		SELECT id, status, COALESCE(reverses, 0), created_at
+		FROM transactions
+		WHERE id = $1;
 	`
 
-	rows, err := r.conn.Query(ctx, queryTpl, account)
+	var t entities.Transaction
+	err := r.pool.QueryRow(ctx, transactionTpl, id).Scan(&t.ID, &t.Status, &t.ReversalOf, &t.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	t.Entries, err = transactionEntries(ctx, r.pool, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// UpdateTransaction locks the transaction, applies update to it and stores
+// the result. Posting a pending transaction applies its entries to the stored
+// balances; its accounts must still accept postings, but their funds are not
+// checked again since the transaction already reserved them.
+func (r *AccountPgxRepository) UpdateTransaction(ctx context.Context, id int64, update func(*entities.Transaction) error) (*entities.Transaction, error) {
+	updateTpl := `
+		UPDATE transactions
+		SET status = $2, settled_at = CASE WHEN $2 = 'pending' THEN NULL ELSE COALESCE(settled_at, NOW()) END
+		WHERE id = $1
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	t, err := lockTransaction(ctx, tx, id)
 	if err != nil {
 		return nil, err
 	}
+
+	status := t.Status
+	err = update(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == entities.TransactionPending && t.Status == entities.TransactionPosted {
+		je := entities.JournalEntry{Entries: t.Entries}
+
+		accounts := make([]string, 0, len(je.Entries))
+		for _, e := range je.Entries {
+			accounts = append(accounts, e.Account)
+		}
+
+		locked, err := lockAccounts(ctx, tx, accounts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range locked {
+			if err := a.CanPost(); err != nil {
+				return nil, fmt.Errorf("%w: %s", err, a.ID)
+			}
+		}
+
+		err = updateBalances(ctx, tx, je)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, updateTpl, t.ID, t.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == entities.TransactionPending && t.Status == entities.TransactionPosted {
+		err = enqueueTransactionPosted(ctx, tx, *t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// ReverseTransaction locks the transaction, lets reverse build the journal
+// entry reversing it and books that entry, checking the funds of all of its
+// accounts, like Journal does. reverse is given the amount the transaction's
+// previous reversals already reversed.
+func (r *AccountPgxRepository) ReverseTransaction(ctx context.Context, id int64, reverse func(t *entities.Transaction, reversed int64) (*entities.JournalEntry, error)) (int64, error) {
+	reversedTpl := `
+		SELECT COALESCE(SUM(e.amount), 0)
+		FROM entries e
+		JOIN transactions t ON t.id = e.transaction_id
+		WHERE t.reverses = $1 AND e.direction = 'debit';
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	t, err := lockTransaction(ctx, tx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	var reversed int64
+	err = tx.QueryRow(ctx, reversedTpl, id).Scan(&reversed)
+	if err != nil {
+		return 0, err
+	}
+
+	je, err := reverse(t, reversed)
+	if err != nil {
+		return 0, err
+	}
+
+	transactionID, err := book(ctx, tx, *je, postingAccounts(*je))
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return transactionID, nil
+}
+
+// lockTransaction takes a row lock on the transaction until the end of tx and
+// returns it with its entries, or domain.ErrTransactionNotFound if it does
+// not exist.
+func lockTransaction(ctx context.Context, tx pgx.Tx, id int64) (*entities.Transaction, error) {
+	queryTpl := `
+		SELECT id, status, COALESCE(reverses, 0), created_at
+		FROM transactions
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	var t entities.Transaction
+	err := tx.QueryRow(ctx, queryTpl, id).Scan(&t.ID, &t.Status, &t.ReversalOf, &t.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	t.Entries, err = transactionEntries(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// transactionEntries returns the entries of the transaction, ordered by ID.
+func transactionEntries(ctx context.Context, q querier, id int64) ([]entities.Entry, error) {
+	queryTpl := `
+		SELECT e.id, e.transaction_id, e.account, e.direction, e.amount, e.currency, t.status, COALESCE(t.reverses, 0), e.created_at
+		FROM entries e
+		JOIN transactions t ON t.id = e.transaction_id
+		WHERE e.transaction_id = $1
+		ORDER BY e.id;
+	`
+
+	rows, err := q.Query(ctx, queryTpl, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanEntries(rows)
+}
+
+func scanEntries(rows pgx.Rows) ([]entities.Entry, error) {
 	defer rows.Close()
 
 	var entries []entities.Entry
 	for rows.Next() {
 		var e entities.Entry
-		err := rows.Scan(&e.ID, &e.Account, &e.Direction, &e.Amount, &e.CreatedAt)
+		var currency string
+		err := rows.Scan(&e.ID, &e.TransactionID, &e.Account, &e.Direction, &e.Amount, &currency, &e.Status, &e.ReversalOf, &e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Currency, err = entities.NewCurrency(currency)
 		if err != nil {
 			return nil, err
 		}
@@ -93,5 +1226,5 @@ func (r *AccountPgxRepository) TransfersHistory(ctx context.Context, account str
 		entries = append(entries, e)
 	}
 
-	return entries, nil
+	return entries, rows.Err()
 }
@@ -0,0 +1,75 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// chainLock is the key of the advisory lock held by the transaction appending
+// to the entries' hash chain.
+const chainLock = 0x6c6564676572
+
+// chainHead takes the hash chain lock until the end of tx and returns the
+// hash of the last entry, empty for an empty ledger, and the creation time of
+// the entries tx books. Holding the lock until commit keeps the entries
+// chained, and committed, in the order of their IDs.
+//
+// The lock is global: bookings on unrelated accounts wait for each other from
+// the moment they take it until they commit, so the ledger books at most one
+// transaction per commit round trip. Callers take it as late as they can.
+func chainHead(ctx context.Context, tx pgx.Tx) (string, time.Time, error) {
+	lockTpl := `SELECT pg_advisory_xact_lock($1)`
+	headTpl := `
+		SELECT COALESCE((SELECT hash FROM entries ORDER BY id DESC LIMIT 1), ''), LOCALTIMESTAMP
+	`
+
+	_, err := tx.Exec(ctx, lockTpl, chainLock)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var prev string
+	var createdAt time.Time
+	err = tx.QueryRow(ctx, headTpl).Scan(&prev, &createdAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return prev, createdAt, nil
+}
+
+// WalkChain calls visit with every entry, oldest first, and the hash stored
+// for it, empty for entries that have none. It stops at the first error visit
+// returns and returns it.
+func (r *AccountPgxRepository) WalkChain(ctx context.Context, visit func(link entities.ChainLink) error) error {
+	queryTpl := `
+		SELECT id, COALESCE(transaction_id, 0), account, direction, amount, currency, created_at, COALESCE(hash, '')
+		FROM entries
+		ORDER BY id;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var link entities.ChainLink
+		err := rows.Scan(&link.Entry.ID, &link.Entry.TransactionID, &link.Entry.Account, &link.Entry.Direction,
+			&link.Entry.Amount, &link.Entry.Currency.Code, &link.Entry.CreatedAt, &link.Hash)
+		if err != nil {
+			return err
+		}
+
+		err = visit(link)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,226 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// CreateHold stores an active hold if the account's available balance, on its
+// normal side, stays within its overdraft limit once the hold's amount is
+// reserved. The account is locked like postings lock it, so holds and
+// postings cannot overdraw it together.
+func (r *AccountPgxRepository) CreateHold(ctx context.Context, hold entities.Hold) (*entities.Hold, error) {
+	queryTpl := `
+		INSERT INTO holds (account, amount, currency, status, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	a, err := lockAccount(ctx, tx, hold.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.CanPost(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, a.ID)
+	}
+
+	available, err := available(ctx, tx, *a, hold.Currency.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.Covers(available - hold.Amount) {
+		return nil, domain.ErrInsufficientFunds
+	}
+
+	err = tx.QueryRow(ctx, queryTpl, hold.Account, hold.Amount, hold.Currency.Code, hold.Status, hold.ExpiresAt.UTC()).Scan(&hold.ID, &hold.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+// Hold returns the hold with the given ID.
+func (r *AccountPgxRepository) Hold(ctx context.Context, id int64) (*entities.Hold, error) {
+	queryTpl := `
+		SELECT id, account, amount, currency, status, captured, COALESCE(transaction_id, 0), expires_at, created_at
+		FROM holds
+		WHERE id = $1;
+	`
+
+	h, err := scanHold(r.pool.QueryRow(ctx, queryTpl, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrHoldNotFound
+		}
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// UpdateHold locks the hold, applies update to it and stores the result. Holds
+// leaving the active status are settled, so they stop reserving funds.
+func (r *AccountPgxRepository) UpdateHold(ctx context.Context, id int64, update func(*entities.Hold) error) (*entities.Hold, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	h, err := lockHold(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = update(h)
+	if err != nil {
+		return nil, err
+	}
+
+	err = storeHold(ctx, tx, h)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// CaptureHold locks the hold, lets capture settle it and books the returned
+// double entry in the same transaction, checking the funds of both accounts
+// once the hold no longer reserves them.
+func (r *AccountPgxRepository) CaptureHold(ctx context.Context, id int64, capture func(*entities.Hold) (*entities.DoubleEntry, error)) (*entities.Hold, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	h, err := lockHold(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	de, err := capture(h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle the hold first, so the funds it reserved are available to book
+	// the capture.
+	err = storeHold(ctx, tx, h)
+	if err != nil {
+		return nil, err
+	}
+
+	je := de.JournalEntry()
+	h.TransactionID, err = book(ctx, tx, je, postingAccounts(je))
+	if err != nil {
+		return nil, err
+	}
+
+	err = storeHold(ctx, tx, h)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// ExpireHolds marks the active holds past their expiry as expired, settled at
+// their expiry, and returns how many there were.
+func (r *AccountPgxRepository) ExpireHolds(ctx context.Context) (int64, error) {
+	queryTpl := `
+		UPDATE holds
+		SET status = $1, settled_at = expires_at
+		WHERE status = $2 AND expires_at <= NOW()
+	`
+
+	tag, err := r.pool.Exec(ctx, queryTpl, entities.HoldExpired, entities.HoldActive)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// lockHold takes a row lock on the hold until the end of tx and returns it,
+// or domain.ErrHoldNotFound if it does not exist.
+func lockHold(ctx context.Context, tx pgx.Tx, id int64) (*entities.Hold, error) {
+	queryTpl := `
+		SELECT id, account, amount, currency, status, captured, COALESCE(transaction_id, 0), expires_at, created_at
+		FROM holds
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	h, err := scanHold(tx.QueryRow(ctx, queryTpl, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrHoldNotFound
+		}
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// storeHold writes the state of the locked hold, settling it once it is no
+// longer active.
+func storeHold(ctx context.Context, tx pgx.Tx, h *entities.Hold) error {
+	queryTpl := `
+		UPDATE holds
+		SET status = $2,
+			captured = $3,
+			transaction_id = NULLIF($4, 0),
+			settled_at = CASE WHEN $2 = 'active' THEN NULL ELSE COALESCE(settled_at, NOW()) END
+		WHERE id = $1
+	`
+
+	_, err := tx.Exec(ctx, queryTpl, h.ID, h.Status, h.Captured, h.TransactionID)
+	return err
+}
+
+func scanHold(row pgx.Row) (*entities.Hold, error) {
+	var h entities.Hold
+	var currency string
+	err := row.Scan(&h.ID, &h.Account, &h.Amount, &currency, &h.Status, &h.Captured, &h.TransactionID, &h.ExpiresAt, &h.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Currency, err = entities.NewCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
@@ -0,0 +1,115 @@
+package pg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHolds(t *testing.T) {
+	repo, conn := setupTestDatabase(t)
+	defer tearDownTestDatabase(conn)
+	openAccounts(t, repo, "external", "account1", "merchant")
+
+	deposit := entities.DoubleEntry{
+		Credit: entities.Entry{Account: "external", Direction: "credit", Amount: 100, Currency: usd},
+		Debit:  entities.Entry{Account: "account1", Direction: "debit", Amount: 100, Currency: usd},
+	}
+	_, err := repo.Transfer(context.Background(), deposit)
+	assert.NoError(t, err)
+
+	hold := func(amount int64, expiresAt time.Time) entities.Hold {
+		return entities.Hold{Account: "account1", Amount: amount, Currency: usd, Status: entities.HoldActive, ExpiresAt: expiresAt}
+	}
+	balance := func() entities.Balance {
+		balances, err := repo.Balance(context.Background(), "account1", nil)
+		assert.NoError(t, err)
+		if !assert.Len(t, balances, 1) {
+			return entities.Balance{}
+		}
+		return balances[0]
+	}
+	transfer := entities.DoubleEntry{
+		Credit: entities.Entry{Account: "account1", Direction: "credit", Amount: 50, Currency: usd},
+		Debit:  entities.Entry{Account: "merchant", Direction: "debit", Amount: 50, Currency: usd},
+	}
+
+	h, err := repo.CreateHold(context.Background(), hold(60, time.Now().Add(time.Hour)))
+	assert.NoError(t, err)
+	assert.NotZero(t, h.ID)
+	assert.Equal(t, entities.Balance{Currency: usd, Amount: 100, Available: 40}, balance())
+
+	t.Run("HeldFundsCannotBeSpent", func(t *testing.T) {
+		_, err := repo.CreateHold(context.Background(), hold(41, time.Now().Add(time.Hour)))
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+
+		_, err = repo.TransferWithFundsCheck(context.Background(), transfer)
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+	})
+
+	t.Run("PartialCapture", func(t *testing.T) {
+		captured, err := repo.CaptureHold(context.Background(), h.ID, func(h *entities.Hold) (*entities.DoubleEntry, error) {
+			if err := h.Capture(45, time.Now()); err != nil {
+				return nil, err
+			}
+			return &entities.DoubleEntry{
+				Credit: entities.Entry{Account: "account1", Direction: "credit", Amount: 45, Currency: usd},
+				Debit:  entities.Entry{Account: "merchant", Direction: "debit", Amount: 45, Currency: usd},
+			}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.HoldCaptured, captured.Status)
+		assert.NotZero(t, captured.TransactionID)
+
+		// The rest of the hold is released with the capture
+		assert.Equal(t, entities.Balance{Currency: usd, Amount: 55, Available: 55}, balance())
+
+		stored, err := repo.Hold(context.Background(), h.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, captured.TransactionID, stored.TransactionID)
+		assert.Equal(t, int64(45), stored.Captured)
+
+		_, err = repo.CaptureHold(context.Background(), h.ID, func(h *entities.Hold) (*entities.DoubleEntry, error) {
+			return nil, h.Capture(1, time.Now())
+		})
+		assert.ErrorIs(t, err, domain.ErrHoldNotActive)
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		h, err := repo.CreateHold(context.Background(), hold(30, time.Now().Add(time.Hour)))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(25), balance().Available)
+
+		released, err := repo.UpdateHold(context.Background(), h.ID, func(h *entities.Hold) error {
+			return h.Release(time.Now())
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.HoldReleased, released.Status)
+		assert.Equal(t, int64(55), balance().Available)
+
+		_, err = repo.UpdateHold(context.Background(), h.ID+100, func(h *entities.Hold) error { return nil })
+		assert.ErrorIs(t, err, domain.ErrHoldNotFound)
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		h, err := repo.CreateHold(context.Background(), hold(30, time.Now().Add(time.Second)))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(25), balance().Available)
+
+		// Lapsed holds stop reserving funds before the worker runs
+		time.Sleep(1100 * time.Millisecond)
+		assert.Equal(t, int64(55), balance().Available)
+
+		expired, err := repo.ExpireHolds(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), expired)
+
+		stored, err := repo.Hold(context.Background(), h.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.HoldExpired, stored.Status)
+	})
+}
@@ -0,0 +1,2 @@
+
+DROP TABLE IF EXISTS accounts;
\ No newline at end of file
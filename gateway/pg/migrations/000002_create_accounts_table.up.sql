@@ -0,0 +1,8 @@
+CREATE TABLE accounts (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+INSERT INTO accounts (id)
+SELECT DISTINCT account FROM entries
+ON CONFLICT (id) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,3 @@
+
+ALTER TABLE entries DROP COLUMN IF EXISTS transaction_id;
+DROP TABLE IF EXISTS transactions;
@@ -0,0 +1,8 @@
+CREATE TABLE transactions (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+ALTER TABLE entries ADD COLUMN transaction_id INTEGER REFERENCES transactions (id);
+
+CREATE INDEX entries_transaction_id_idx ON entries (transaction_id);
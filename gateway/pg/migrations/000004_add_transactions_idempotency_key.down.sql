@@ -0,0 +1,4 @@
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS request_hash,
+    DROP COLUMN IF EXISTS idempotency_key;
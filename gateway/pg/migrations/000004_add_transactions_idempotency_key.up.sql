@@ -0,0 +1,3 @@
+ALTER TABLE transactions
+    ADD COLUMN idempotency_key VARCHAR(255) UNIQUE,
+    ADD COLUMN request_hash CHAR(64);
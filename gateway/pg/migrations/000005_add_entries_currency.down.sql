@@ -0,0 +1,3 @@
+
+DROP INDEX IF EXISTS entries_account_currency_idx;
+ALTER TABLE entries DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,4 @@
+ALTER TABLE entries ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE entries ALTER COLUMN currency DROP DEFAULT;
+
+CREATE INDEX entries_account_currency_idx ON entries (account, currency);
@@ -0,0 +1 @@
+CREATE INDEX entries_account_created_at_id_idx ON entries (account, created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+
+DROP INDEX IF EXISTS entries_account_created_at_idx;
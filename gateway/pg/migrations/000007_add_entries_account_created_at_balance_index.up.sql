@@ -0,0 +1 @@
+CREATE INDEX entries_account_created_at_idx ON entries (account, created_at) INCLUDE (currency, direction, amount);
@@ -0,0 +1,16 @@
+CREATE TABLE account_balances (
+    account VARCHAR(255) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    balance BIGINT NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (account, currency)
+);
+
+INSERT INTO account_balances (account, currency, balance)
+SELECT
+    account,
+    currency,
+    SUM(CASE WHEN direction = 'debit' THEN amount ELSE 0 END) -
+    SUM(CASE WHEN direction = 'credit' THEN amount ELSE 0 END)
+FROM entries
+GROUP BY account, currency;
@@ -0,0 +1,4 @@
+
+DROP INDEX IF EXISTS accounts_owner_id_idx;
+ALTER TABLE accounts DROP COLUMN IF EXISTS status;
+ALTER TABLE accounts DROP COLUMN IF EXISTS owner;
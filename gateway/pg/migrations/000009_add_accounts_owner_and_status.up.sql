@@ -0,0 +1,8 @@
+ALTER TABLE accounts
+    ADD COLUMN owner VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'frozen', 'closed'));
+
+CREATE INDEX accounts_owner_id_idx ON accounts (owner, id);
+
+INSERT INTO accounts (id, owner) VALUES ('external', 'system')
+ON CONFLICT (id) DO UPDATE SET owner = EXCLUDED.owner;
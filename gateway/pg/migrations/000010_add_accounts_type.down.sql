@@ -0,0 +1,3 @@
+
+DROP INDEX IF EXISTS accounts_type_id_idx;
+ALTER TABLE accounts DROP COLUMN IF EXISTS type;
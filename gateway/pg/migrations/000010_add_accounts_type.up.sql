@@ -0,0 +1,6 @@
+ALTER TABLE accounts
+    ADD COLUMN type VARCHAR(16) NOT NULL DEFAULT 'asset' CHECK (type IN ('asset', 'liability', 'equity', 'revenue', 'expense'));
+
+CREATE INDEX accounts_type_id_idx ON accounts (type, id);
+
+UPDATE accounts SET type = 'equity' WHERE id = 'external';
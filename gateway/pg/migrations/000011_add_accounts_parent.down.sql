@@ -0,0 +1,3 @@
+
+DROP INDEX IF EXISTS accounts_parent_idx;
+ALTER TABLE accounts DROP COLUMN IF EXISTS parent;
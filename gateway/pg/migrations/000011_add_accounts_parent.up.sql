@@ -0,0 +1,3 @@
+ALTER TABLE accounts ADD COLUMN parent VARCHAR(255) REFERENCES accounts (id);
+
+CREATE INDEX accounts_parent_idx ON accounts (parent);
@@ -0,0 +1,3 @@
+
+DROP TABLE IF EXISTS account_overdraft_limit_changes;
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_limit;
@@ -0,0 +1,15 @@
+ALTER TABLE accounts
+    ADD COLUMN overdraft_limit BIGINT NOT NULL DEFAULT 0 CHECK (overdraft_limit >= -1);
+
+UPDATE accounts SET overdraft_limit = -1 WHERE id = 'external';
+
+CREATE TABLE account_overdraft_limit_changes (
+    id BIGSERIAL PRIMARY KEY,
+    account VARCHAR(255) NOT NULL REFERENCES accounts (id),
+    old_limit BIGINT NOT NULL,
+    new_limit BIGINT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX account_overdraft_limit_changes_account_idx ON account_overdraft_limit_changes (account, id DESC);
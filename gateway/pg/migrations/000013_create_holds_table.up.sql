@@ -0,0 +1,15 @@
+CREATE TABLE holds (
+    id BIGSERIAL PRIMARY KEY,
+    account VARCHAR(255) NOT NULL REFERENCES accounts (id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'captured', 'released', 'expired')),
+    captured BIGINT NOT NULL DEFAULT 0,
+    transaction_id INTEGER REFERENCES transactions (id),
+    expires_at TIMESTAMP NOT NULL,
+    settled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX holds_account_currency_idx ON holds (account, currency) WHERE settled_at IS NULL;
+CREATE INDEX holds_expires_at_idx ON holds (expires_at) WHERE status = 'active';
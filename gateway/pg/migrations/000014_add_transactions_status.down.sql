@@ -0,0 +1,3 @@
+
+DROP INDEX IF EXISTS transactions_pending_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS settled_at, DROP COLUMN IF EXISTS status;
@@ -0,0 +1,7 @@
+ALTER TABLE transactions
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'posted' CHECK (status IN ('pending', 'posted', 'voided')),
+    ADD COLUMN settled_at TIMESTAMP;
+
+UPDATE transactions SET settled_at = created_at;
+
+CREATE INDEX transactions_pending_idx ON transactions (id) WHERE status = 'pending';
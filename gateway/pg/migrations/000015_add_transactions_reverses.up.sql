@@ -0,0 +1,3 @@
+ALTER TABLE transactions ADD COLUMN reverses INTEGER REFERENCES transactions (id);
+
+CREATE INDEX transactions_reverses_idx ON transactions (reverses) WHERE reverses IS NOT NULL;
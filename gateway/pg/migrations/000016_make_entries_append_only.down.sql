@@ -0,0 +1,5 @@
+
+DROP TRIGGER IF EXISTS entries_append_only_truncate ON entries;
+DROP TRIGGER IF EXISTS entries_append_only ON entries;
+DROP FUNCTION IF EXISTS entries_append_only();
+ALTER TABLE entries DROP CONSTRAINT IF EXISTS entries_amount_check, DROP CONSTRAINT IF EXISTS entries_direction_check;
@@ -0,0 +1,22 @@
+ALTER TABLE entries
+    ADD CONSTRAINT entries_direction_check CHECK (direction IN ('debit', 'credit')),
+    ADD CONSTRAINT entries_amount_check CHECK (amount > 0);
+
+CREATE FUNCTION entries_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'UPDATE' AND current_setting('ledger.allow_entries_purge', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'entries are append-only: % is not allowed', TG_OP
+        USING ERRCODE = 'insufficient_privilege';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER entries_append_only
+    BEFORE UPDATE OR DELETE ON entries
+    FOR EACH ROW EXECUTE FUNCTION entries_append_only();
+
+CREATE TRIGGER entries_append_only_truncate
+    BEFORE TRUNCATE ON entries
+    FOR EACH STATEMENT EXECUTE FUNCTION entries_append_only();
@@ -0,0 +1,26 @@
+ALTER TABLE entries ADD COLUMN hash CHAR(64);
+
+ALTER TABLE entries DISABLE TRIGGER entries_append_only;
+
+DO $$
+DECLARE
+    e RECORD;
+    prev TEXT := '';
+BEGIN
+    FOR e IN SELECT * FROM entries ORDER BY id LOOP
+        prev := encode(sha256(convert_to(concat_ws(E'\n',
+            prev,
+            COALESCE(e.transaction_id, 0),
+            e.account,
+            e.direction,
+            e.amount,
+            e.currency,
+            COALESCE((EXTRACT(EPOCH FROM e.created_at) * 1000000)::BIGINT, 0)
+        ), 'UTF8')), 'hex');
+
+        UPDATE entries SET hash = prev WHERE id = e.id;
+    END LOOP;
+END;
+$$;
+
+ALTER TABLE entries ENABLE TRIGGER entries_append_only;
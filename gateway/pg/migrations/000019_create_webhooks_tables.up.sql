@@ -0,0 +1,27 @@
+CREATE TABLE webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    account VARCHAR(255) NOT NULL REFERENCES accounts (id),
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'disabled')),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX webhooks_account_idx ON webhooks (account) WHERE status = 'active';
+
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL REFERENCES webhooks (id),
+    event_id BIGINT NOT NULL REFERENCES outbox (id),
+    account VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_status_code INTEGER,
+    last_error TEXT,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,15 @@
+
+CREATE OR REPLACE FUNCTION entries_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'UPDATE' AND current_setting('ledger.allow_entries_purge', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'entries are append-only: % is not allowed', TG_OP
+        USING ERRCODE = 'insufficient_privilege';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP OWNED BY ledger_app, ledger_maintenance;
+DROP ROLE IF EXISTS ledger_app;
+DROP ROLE IF EXISTS ledger_maintenance;
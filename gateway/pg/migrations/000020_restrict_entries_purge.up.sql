@@ -0,0 +1,34 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'ledger_app') THEN
+        CREATE ROLE ledger_app NOLOGIN;
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'ledger_maintenance') THEN
+        CREATE ROLE ledger_maintenance NOLOGIN;
+    END IF;
+END;
+$$;
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO ledger_app;
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO ledger_app;
+GRANT SELECT, DELETE, TRUNCATE ON ALL TABLES IN SCHEMA public TO ledger_maintenance;
+
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO ledger_app;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO ledger_app;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, DELETE, TRUNCATE ON TABLES TO ledger_maintenance;
+
+REVOKE UPDATE, DELETE, TRUNCATE ON entries FROM PUBLIC, ledger_app;
+
+CREATE OR REPLACE FUNCTION entries_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'UPDATE'
+        AND current_user = 'ledger_maintenance'
+        AND current_setting('ledger.allow_entries_purge', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'entries are append-only: % is not allowed', TG_OP
+        USING ERRCODE = 'insufficient_privilege';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS entries_account_created_at_idx;
+DROP INDEX IF EXISTS entries_account_created_at_id_idx;
+CREATE INDEX entries_account_created_at_id_idx ON entries (account, created_at DESC, id DESC) INCLUDE (currency, direction, amount);
@@ -0,0 +1,108 @@
+package pg
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// enqueueTransactionPosted stores the event announcing the posted
+// transaction in the outbox, along with its deliveries to the webhooks
+// subscribed to the transaction's accounts. It must run in the transaction
+// posting it, so the event is committed if and only if the transaction is.
+func enqueueTransactionPosted(ctx context.Context, tx pgx.Tx, t entities.Transaction) error {
+	queryTpl := `
+		INSERT INTO outbox (event_type, transaction_id, payload)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+
+	event, err := entities.NewTransactionPostedEvent(t)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, queryTpl, event.Type, event.TransactionID, event.Payload).Scan(&event.ID)
+	if err != nil {
+		return err
+	}
+
+	accounts := make([]string, 0, len(t.Entries))
+	for _, e := range t.Entries {
+		accounts = append(accounts, e.Account)
+	}
+
+	return enqueueWebhookDeliveries(ctx, tx, event.ID, accounts)
+}
+
+// RelayEvents locks up to limit unpublished events, oldest first, skipping
+// the ones other relays locked, and calls publish with them. The first n
+// events publish reports are marked as published in the same transaction,
+// even when publish also fails, so they are not published again.
+func (r *AccountPgxRepository) RelayEvents(ctx context.Context, limit int, publish func(events []entities.Event) (int, error)) (int, error) {
+	lockTpl := `
+		SELECT id, event_type, transaction_id, payload, created_at
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	markTpl := `
+		UPDATE outbox
+		SET published_at = NOW()
+		WHERE id = ANY($1)
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, lockTpl, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var events []entities.Event
+	for rows.Next() {
+		var e entities.Event
+		err := rows.Scan(&e.ID, &e.Type, &e.TransactionID, &e.Payload, &e.CreatedAt)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		events = append(events, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	n, publishErr := publish(events)
+	if n > 0 {
+		ids := make([]int64, 0, n)
+		for _, e := range events[:n] {
+			ids = append(ids, e.ID)
+		}
+
+		_, err = tx.Exec(ctx, markTpl, ids)
+		if err != nil {
+			return 0, err
+		}
+
+		err = tx.Commit(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return n, publishErr
+}
@@ -0,0 +1,83 @@
+package pg_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutbox(t *testing.T) {
+	repo, conn := setupTestDatabase(t)
+	defer tearDownTestDatabase(conn)
+	openAccounts(t, repo, "external", "account1", "account2")
+
+	transfer := func(from, to string, amount int64) entities.DoubleEntry {
+		return entities.DoubleEntry{
+			Credit: entities.Entry{Account: from, Direction: "credit", Amount: amount, Currency: usd},
+			Debit:  entities.Entry{Account: to, Direction: "debit", Amount: amount, Currency: usd},
+		}
+	}
+
+	deposit, err := repo.Transfer(context.Background(), transfer("external", "account1", 100))
+	assert.NoError(t, err)
+	// Pending transactions are announced when they are posted
+	pending, err := repo.PendingTransfer(context.Background(), transfer("account1", "account2", 40))
+	assert.NoError(t, err)
+	// Rolled back transactions are never announced
+	_, err = repo.TransferWithFundsCheck(context.Background(), transfer("account1", "account2", 500))
+	assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+
+	relayed := func(fail int) ([]entities.Event, error) {
+		var events []entities.Event
+		_, err := repo.RelayEvents(context.Background(), 10, func(batch []entities.Event) (int, error) {
+			for i, e := range batch {
+				if i == fail {
+					return i, assert.AnError
+				}
+				events = append(events, e)
+			}
+			return len(batch), nil
+		})
+		return events, err
+	}
+
+	events, err := relayed(-1)
+	assert.NoError(t, err)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, entities.EventTransactionPosted, events[0].Type)
+		assert.Equal(t, deposit, events[0].TransactionID)
+
+		var payload entities.Transaction
+		assert.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+		assert.Equal(t, deposit, payload.ID)
+		assert.Equal(t, entities.TransactionPosted, payload.Status)
+		assert.Len(t, payload.Entries, 2)
+	}
+
+	// Published events are not relayed again
+	events, err = relayed(-1)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	_, err = repo.UpdateTransaction(context.Background(), pending, func(t *entities.Transaction) error {
+		return t.Post()
+	})
+	assert.NoError(t, err)
+	_, err = repo.Transfer(context.Background(), transfer("external", "account2", 5))
+	assert.NoError(t, err)
+
+	// Events after a failure are kept for the next relay
+	events, err = relayed(1)
+	assert.ErrorIs(t, err, assert.AnError)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, pending, events[0].TransactionID)
+	}
+
+	events, err = relayed(-1)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+}
@@ -0,0 +1,116 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// TrialBalance adds up the posted debits and credits of every account in each
+// currency straight from the entries, ordered by account and currency. With
+// asOf it only counts the entries created and posted up to that time, like
+// Balance does.
+func (r *AccountPgxRepository) TrialBalance(ctx context.Context, asOf *time.Time) ([]entities.TrialBalanceLine, error) {
+	currentTpl := `
+		SELECT
+			e.account,
+			e.currency,
+			SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END),
+			SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END)
+		FROM entries e
+		LEFT JOIN transactions t ON t.id = e.transaction_id
+		WHERE t.id IS NULL OR t.status = 'posted'
+		GROUP BY e.account, e.currency
+		ORDER BY 1, 2;
+	`
+	asOfTpl := `
+		SELECT
+			e.account,
+			e.currency,
+			SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END),
+			SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END)
+		FROM entries e
+		LEFT JOIN transactions t ON t.id = e.transaction_id
+		WHERE e.created_at <= $1
+			AND (t.id IS NULL OR (t.status = 'posted' AND t.settled_at <= $1))
+		GROUP BY e.account, e.currency
+		ORDER BY 1, 2;
+	`
+
+	var rows pgx.Rows
+	var err error
+	if asOf != nil {
+		rows, err = r.pool.Query(ctx, asOfTpl, asOf.UTC())
+	} else {
+		rows, err = r.pool.Query(ctx, currentTpl)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []entities.TrialBalanceLine
+	for rows.Next() {
+		var l entities.TrialBalanceLine
+		var currency string
+		err := rows.Scan(&l.Account, &currency, &l.Debits, &l.Credits)
+		if err != nil {
+			return nil, err
+		}
+
+		l.Currency, err = entities.NewCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, l)
+	}
+
+	return lines, rows.Err()
+}
+
+// UnbalancedTransactions returns the transactions, pending and voided ones
+// included, whose debits and credits in a currency differ, ordered by
+// transaction and currency.
+func (r *AccountPgxRepository) UnbalancedTransactions(ctx context.Context) ([]entities.UnbalancedTransaction, error) {
+	queryTpl := `
+		SELECT
+			e.transaction_id,
+			e.currency,
+			SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END),
+			SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END)
+		FROM entries e
+		WHERE e.transaction_id IS NOT NULL
+		GROUP BY e.transaction_id, e.currency
+		HAVING SUM(CASE WHEN e.direction = 'debit' THEN e.amount ELSE 0 END) <>
+			SUM(CASE WHEN e.direction = 'credit' THEN e.amount ELSE 0 END)
+		ORDER BY 1, 2;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var unbalanced []entities.UnbalancedTransaction
+	for rows.Next() {
+		var u entities.UnbalancedTransaction
+		var currency string
+		err := rows.Scan(&u.TransactionID, &currency, &u.Debits, &u.Credits)
+		if err != nil {
+			return nil, err
+		}
+
+		u.Currency, err = entities.NewCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+
+		unbalanced = append(unbalanced, u)
+	}
+
+	return unbalanced, rows.Err()
+}
@@ -0,0 +1,81 @@
+package pg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrialBalance(t *testing.T) {
+	repo, conn := setupTestDatabase(t)
+	defer tearDownTestDatabase(conn)
+	openAccounts(t, repo, "external", "account1", "account2")
+
+	transfer := func(from, to string, amount int64) entities.DoubleEntry {
+		return entities.DoubleEntry{
+			Credit: entities.Entry{Account: from, Direction: "credit", Amount: amount, Currency: usd},
+			Debit:  entities.Entry{Account: to, Direction: "debit", Amount: amount, Currency: usd},
+		}
+	}
+
+	_, err := repo.Transfer(context.Background(), transfer("external", "account1", 100))
+	assert.NoError(t, err)
+
+	beforeSecond := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	_, err = repo.Transfer(context.Background(), transfer("account1", "account2", 40))
+	assert.NoError(t, err)
+	// Pending transfers are left out until they are posted
+	_, err = repo.PendingTransfer(context.Background(), transfer("account1", "account2", 10))
+	assert.NoError(t, err)
+
+	lines, err := repo.TrialBalance(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.TrialBalanceLine{
+		{Account: "account1", Currency: usd, Debits: 100, Credits: 40},
+		{Account: "account2", Currency: usd, Debits: 40},
+		{Account: "external", Currency: usd, Credits: 100},
+	}, lines)
+
+	lines, err = repo.TrialBalance(context.Background(), &beforeSecond)
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.TrialBalanceLine{
+		{Account: "account1", Currency: usd, Debits: 100},
+		{Account: "external", Currency: usd, Credits: 100},
+	}, lines)
+}
+
+func TestUnbalancedTransactions(t *testing.T) {
+	repo, conn := setupTestDatabase(t)
+	defer tearDownTestDatabase(conn)
+	openAccounts(t, repo, "external", "account1")
+
+	_, err := repo.Transfer(context.Background(), entities.DoubleEntry{
+		Credit: entities.Entry{Account: "external", Direction: "credit", Amount: 100, Currency: usd},
+		Debit:  entities.Entry{Account: "account1", Direction: "debit", Amount: 100, Currency: usd},
+	})
+	assert.NoError(t, err)
+
+	unbalanced, err := repo.UnbalancedTransactions(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, unbalanced)
+
+	// A transaction missing a leg, as if written around the repository
+	var transactionID int64
+	err = conn.QueryRow(context.Background(), "INSERT INTO transactions DEFAULT VALUES RETURNING id").Scan(&transactionID)
+	assert.NoError(t, err)
+	_, err = conn.Exec(context.Background(),
+		"INSERT INTO entries (transaction_id, account, direction, amount, currency) VALUES ($1, 'account1', 'debit', 30, 'USD')",
+		transactionID)
+	assert.NoError(t, err)
+
+	unbalanced, err = repo.UnbalancedTransactions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.UnbalancedTransaction{
+		{TransactionID: transactionID, Currency: usd, Debits: 30},
+	}, unbalanced)
+}
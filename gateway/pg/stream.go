@@ -0,0 +1,160 @@
+package pg
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// entriesChannel is the channel notified, with the account as payload, when
+// entries of an account are committed.
+const entriesChannel = "ledger_entries"
+
+// LastEntryID returns the ID of the last committed entry, or zero if there is
+// none. Bookings hold the hash chain lock until they commit, so entries are
+// committed in the order of their IDs.
+func (r *AccountPgxRepository) LastEntryID(ctx context.Context) (int64, error) {
+	queryTpl := `
+		SELECT COALESCE(MAX(id), 0) FROM entries;
+	`
+
+	var id int64
+	err := r.pool.QueryRow(ctx, queryTpl).Scan(&id)
+	return id, err
+}
+
+// EntriesAfter returns up to limit entries of the account whose ID is greater
+// than after, ordered by ID.
+func (r *AccountPgxRepository) EntriesAfter(ctx context.Context, account string, after int64, limit int) ([]entities.Entry, error) {
+	queryTpl := `
+		SELECT e.id, COALESCE(e.transaction_id, 0), e.account, e.direction, e.amount, e.currency, COALESCE(t.status, 'posted'), COALESCE(t.reverses, 0), e.created_at
+		FROM entries e
+		LEFT JOIN transactions t ON t.id = e.transaction_id
+		WHERE e.account = $1 AND e.id > $2
+		ORDER BY e.id
+		LIMIT $3;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl, account, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanEntries(rows)
+}
+
+// notifyEntries notifies the listeners of entriesChannel that entries of the
+// accounts were booked. Postgres delivers the notifications when tx commits,
+// and drops them if it rolls back.
+func notifyEntries(ctx context.Context, tx pgx.Tx, accounts []string) error {
+	queryTpl := `
+		SELECT pg_notify($1, account) FROM unnest($2::text[]) AS account
+	`
+
+	_, err := tx.Exec(ctx, queryTpl, entriesChannel, accounts)
+	return err
+}
+
+// EntryListener fans the notifications of entriesChannel out to the
+// subscribers of each account, over a single connection.
+type EntryListener struct {
+	pool *pgxpool.Pool
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+// NewEntryListener returns a new EntryListener. Subscribers are only notified
+// while Listen runs.
+func NewEntryListener(pool *pgxpool.Pool) *EntryListener {
+	return &EntryListener{
+		pool:        pool,
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving a value when entries of the account
+// are committed, and a function to call to unsubscribe. Notifications are
+// coalesced while the subscriber is busy.
+func (l *EntryListener) Subscribe(account string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.subscribers[account] == nil {
+		l.subscribers[account] = make(map[chan struct{}]struct{})
+	}
+	l.subscribers[account][ch] = struct{}{}
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		delete(l.subscribers[account], ch)
+		if len(l.subscribers[account]) == 0 {
+			delete(l.subscribers, account)
+		}
+	}
+}
+
+// Listen takes a connection out of the pool, listens on entriesChannel and
+// notifies the subscribers until ctx is done or the connection fails.
+// Notifications sent while no connection listens are lost, so every
+// subscriber is notified once listening starts, to catch up.
+func (l *EntryListener) Listen(ctx context.Context) error {
+	c, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn := c.Hijack()
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+entriesChannel)
+	if err != nil {
+		return err
+	}
+
+	l.notifyAll()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		l.notify(n.Payload)
+	}
+}
+
+// notify wakes up the subscribers of the account, without waiting for the
+// ones already notified.
+func (l *EntryListener) notify(account string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	wake(l.subscribers[account])
+}
+
+// notifyAll wakes up every subscriber.
+func (l *EntryListener) notifyAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, subscribers := range l.subscribers {
+		wake(subscribers)
+	}
+}
+
+func wake(subscribers map[chan struct{}]struct{}) {
+	for ch := range subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package pg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/julioc98/ledger/gateway/pg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntryStream(t *testing.T) {
+	repo, conn := setupTestDatabase(t)
+	defer tearDownTestDatabase(conn)
+	openAccounts(t, repo, "external", "account1", "account2")
+
+	transfer := func(from, to string, amount int64) {
+		_, err := repo.Transfer(context.Background(), entities.DoubleEntry{
+			Credit: entities.Entry{Account: from, Direction: "credit", Amount: amount, Currency: usd},
+			Debit:  entities.Entry{Account: to, Direction: "debit", Amount: amount, Currency: usd},
+		})
+		assert.NoError(t, err)
+	}
+
+	last, err := repo.LastEntryID(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, last)
+
+	listener := pg.NewEntryListener(conn)
+	account1, unsubscribe := listener.Subscribe("account1")
+	defer unsubscribe()
+	account2, unsubscribe2 := listener.Subscribe("account2")
+	unsubscribe2()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- listener.Listen(ctx)
+	}()
+
+	received := func() bool {
+		select {
+		case <-account1:
+			return true
+		case <-time.After(time.Second):
+			return false
+		}
+	}
+
+	// Subscribers catch up once the listener starts
+	assert.True(t, received())
+
+	transfer("external", "account1", 100)
+	assert.True(t, received())
+	transfer("external", "account2", 50)
+	transfer("account1", "account2", 30)
+	assert.True(t, received())
+	select {
+	case <-account2:
+		t.Error("unsubscribed channels must not be notified")
+	default:
+	}
+
+	entries, err := repo.EntriesAfter(context.Background(), "account1", 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, entities.Debit, entries[0].Direction)
+		assert.Equal(t, int64(100), entries[0].Amount)
+		assert.Equal(t, entities.Credit, entries[1].Direction)
+		assert.Equal(t, int64(30), entries[1].Amount)
+		assert.Equal(t, entities.TransactionPosted, entries[1].Status)
+
+		page, err := repo.EntriesAfter(context.Background(), "account1", entries[0].ID, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, entries[1:], page)
+	}
+
+	last, err = repo.LastEntryID(context.Background())
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, last, entries[1].ID)
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Listen did not stop when the context was cancelled")
+	}
+}
@@ -0,0 +1,227 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// CreateWebhook stores an active webhook subscription on a registered
+// account.
+func (r *AccountPgxRepository) CreateWebhook(ctx context.Context, webhook entities.Webhook) (*entities.Webhook, error) {
+	queryTpl := `
+		INSERT INTO webhooks (account, url, secret, status)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	if _, err := r.Account(ctx, webhook.Account); err != nil {
+		return nil, err
+	}
+
+	err := r.pool.QueryRow(ctx, queryTpl, webhook.Account, webhook.URL, webhook.Secret, webhook.Status).Scan(&webhook.ID, &webhook.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// Webhooks returns the webhook subscriptions of the account, or of every
+// account when it is empty, ordered by ID.
+func (r *AccountPgxRepository) Webhooks(ctx context.Context, account string) ([]entities.Webhook, error) {
+	queryTpl := `
+		SELECT id, account, url, secret, status, created_at
+		FROM webhooks
+		WHERE $1 = '' OR account = $1
+		ORDER BY id;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl, account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []entities.Webhook{}
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, *w)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// DisableWebhook disables the webhook subscription and returns it. Its
+// pending deliveries are no longer claimed.
+func (r *AccountPgxRepository) DisableWebhook(ctx context.Context, id int64) (*entities.Webhook, error) {
+	queryTpl := `
+		UPDATE webhooks
+		SET status = $2
+		WHERE id = $1
+		RETURNING id, account, url, secret, status, created_at
+	`
+
+	w, err := scanWebhook(r.pool.QueryRow(ctx, queryTpl, id, entities.WebhookDisabled))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// WebhookDeliveries returns up to query.Limit deliveries of the webhook
+// subscription, newest first.
+func (r *AccountPgxRepository) WebhookDeliveries(ctx context.Context, query entities.WebhookDeliveryQuery) ([]entities.WebhookDelivery, error) {
+	existsTpl := `
+		SELECT EXISTS (SELECT 1 FROM webhooks WHERE id = $1);
+	`
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, existsTpl, query.WebhookID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	args := []any{query.WebhookID}
+	where := []string{"d.webhook_id = $1"}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if query.Before > 0 {
+		where = append(where, "d.id < "+arg(query.Before))
+	}
+
+	queryTpl := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		JOIN webhooks w ON w.id = d.webhook_id
+		JOIN outbox o ON o.id = d.event_id
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY d.id DESC
+		LIMIT ` + arg(query.Limit) + `;
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanWebhookDeliveries(rows)
+}
+
+// ClaimWebhookDeliveries locks up to limit pending deliveries of active
+// subscriptions that are due, the longest due first, skipping the ones other
+// workers locked, and postpones their next attempt by lease. A worker that
+// dies before recording the attempt leaves them to be claimed again once the
+// lease is over.
+func (r *AccountPgxRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	queryTpl := `
+		WITH due AS (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhooks w ON w.id = d.webhook_id
+			WHERE d.status = $1 AND w.status = $2 AND d.next_attempt_at <= NOW()
+			ORDER BY d.next_attempt_at, d.id
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $4)
+		FROM due, webhooks w, outbox o
+		WHERE d.id = due.id AND w.id = d.webhook_id AND o.id = d.event_id
+		RETURNING ` + webhookDeliveryColumns + `
+	`
+
+	rows, err := r.pool.Query(ctx, queryTpl, entities.WebhookDeliveryPending, entities.WebhookActive, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+
+	return scanWebhookDeliveries(rows)
+}
+
+// RecordWebhookAttempt stores the outcome of an attempt at a delivery.
+func (r *AccountPgxRepository) RecordWebhookAttempt(ctx context.Context, delivery entities.WebhookDelivery) error {
+	queryTpl := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = NULLIF($5, 0), last_error = NULLIF($6, ''), delivered_at = $7
+		WHERE id = $1
+	`
+
+	var deliveredAt *time.Time
+	if delivery.DeliveredAt != nil {
+		t := delivery.DeliveredAt.UTC()
+		deliveredAt = &t
+	}
+
+	_, err := r.pool.Exec(ctx, queryTpl, delivery.ID, delivery.Status, delivery.Attempts, delivery.NextAttemptAt.UTC(), delivery.LastStatusCode, delivery.LastError, deliveredAt)
+	return err
+}
+
+// enqueueWebhookDeliveries stores a delivery of the event to every active
+// webhook subscribed to one of the accounts. It must run in the transaction
+// storing the event.
+func enqueueWebhookDeliveries(ctx context.Context, tx pgx.Tx, eventID int64, accounts []string) error {
+	queryTpl := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, account)
+		SELECT id, $1, account
+		FROM webhooks
+		WHERE status = $2 AND account = ANY($3)
+	`
+
+	_, err := tx.Exec(ctx, queryTpl, eventID, entities.WebhookActive, accounts)
+	return err
+}
+
+// webhookDeliveryColumns selects a delivery joined, as d, with its webhook,
+// as w, and its event, as o, in the order scanWebhookDeliveries reads them.
+const webhookDeliveryColumns = `d.id, d.webhook_id, d.event_id, o.event_type, o.transaction_id, d.account, w.url, w.secret, o.payload,
+			d.status, d.attempts, d.next_attempt_at, COALESCE(d.last_status_code, 0), COALESCE(d.last_error, ''), d.delivered_at, d.created_at`
+
+func scanWebhookDeliveries(rows pgx.Rows) ([]entities.WebhookDelivery, error) {
+	defer rows.Close()
+
+	deliveries := []entities.WebhookDelivery{}
+	for rows.Next() {
+		var d entities.WebhookDelivery
+		err := rows.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.EventType, &d.TransactionID, &d.Account, &d.URL, &d.Secret, &d.Payload,
+			&d.Status, &d.Attempts, &d.NextAttemptAt, &d.LastStatusCode, &d.LastError, &d.DeliveredAt, &d.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func scanWebhook(row pgx.Row) (*entities.Webhook, error) {
+	var w entities.Webhook
+	err := row.Scan(&w.ID, &w.Account, &w.URL, &w.Secret, &w.Status, &w.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
@@ -0,0 +1,113 @@
+package pg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/julioc98/ledger/domain"
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooks(t *testing.T) {
+	repo, conn := setupTestDatabase(t)
+	defer tearDownTestDatabase(conn)
+	openAccounts(t, repo, "external", "account1", "account2")
+
+	_, err := repo.CreateWebhook(context.Background(), entities.Webhook{Account: "unknown", URL: "https://a.example/hooks", Secret: "whsec", Status: entities.WebhookActive})
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound)
+
+	webhook, err := repo.CreateWebhook(context.Background(), entities.Webhook{Account: "account1", URL: "https://a.example/hooks", Secret: "whsec", Status: entities.WebhookActive})
+	assert.NoError(t, err)
+	other, err := repo.CreateWebhook(context.Background(), entities.Webhook{Account: "account2", URL: "https://b.example/hooks", Secret: "whsec", Status: entities.WebhookActive})
+	assert.NoError(t, err)
+
+	webhooks, err := repo.Webhooks(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, webhooks, 2)
+	webhooks, err = repo.Webhooks(context.Background(), "account1")
+	assert.NoError(t, err)
+	if assert.Len(t, webhooks, 1) {
+		assert.Equal(t, webhook.ID, webhooks[0].ID)
+		assert.Equal(t, "https://a.example/hooks", webhooks[0].URL)
+	}
+
+	transfer := func(from, to string, amount int64) int64 {
+		id, err := repo.Transfer(context.Background(), entities.DoubleEntry{
+			Credit: entities.Entry{Account: from, Direction: "credit", Amount: amount, Currency: usd},
+			Debit:  entities.Entry{Account: to, Direction: "debit", Amount: amount, Currency: usd},
+		})
+		assert.NoError(t, err)
+		return id
+	}
+
+	deposit := transfer("external", "account1", 100)
+	// Both subscriptions are notified of transactions between their accounts
+	transfer("account1", "account2", 40)
+
+	_, err = repo.WebhookDeliveries(context.Background(), entities.WebhookDeliveryQuery{WebhookID: other.ID + 1, Limit: 10})
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+
+	claimed, err := repo.ClaimWebhookDeliveries(context.Background(), 10, time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 3)
+
+	// Claimed deliveries are leased
+	again, err := repo.ClaimWebhookDeliveries(context.Background(), 10, time.Minute)
+	assert.NoError(t, err)
+	assert.Empty(t, again)
+
+	for _, d := range claimed {
+		assert.Equal(t, "whsec", d.Secret)
+		assert.Equal(t, entities.EventTransactionPosted, d.EventType)
+		assert.NotEmpty(t, d.Payload)
+
+		if d.TransactionID == deposit {
+			d.Succeed(time.Now(), 204)
+		} else {
+			d.Fail(time.Now().Add(-time.Hour), 500, assert.AnError)
+		}
+		assert.NoError(t, repo.RecordWebhookAttempt(context.Background(), d))
+	}
+
+	deliveries, err := repo.WebhookDeliveries(context.Background(), entities.WebhookDeliveryQuery{WebhookID: webhook.ID, Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, deliveries, 2) {
+		assert.Greater(t, deliveries[0].ID, deliveries[1].ID)
+
+		assert.Equal(t, entities.WebhookDeliveryPending, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.Equal(t, 500, deliveries[0].LastStatusCode)
+		assert.Equal(t, assert.AnError.Error(), deliveries[0].LastError)
+		assert.Nil(t, deliveries[0].DeliveredAt)
+
+		assert.Equal(t, entities.WebhookDeliverySucceeded, deliveries[1].Status)
+		assert.Equal(t, deposit, deliveries[1].TransactionID)
+		assert.Equal(t, 204, deliveries[1].LastStatusCode)
+		assert.NotNil(t, deliveries[1].DeliveredAt)
+
+		page, err := repo.WebhookDeliveries(context.Background(), entities.WebhookDeliveryQuery{WebhookID: webhook.ID, Before: deliveries[0].ID, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, deliveries[1:], page)
+	}
+
+	// The failed deliveries were scheduled in the past, so they are due again
+	// unless their subscription is disabled
+	_, err = repo.DisableWebhook(context.Background(), other.ID)
+	assert.NoError(t, err)
+	claimed, err = repo.ClaimWebhookDeliveries(context.Background(), 10, time.Minute)
+	assert.NoError(t, err)
+	if assert.Len(t, claimed, 1) {
+		assert.Equal(t, webhook.ID, claimed[0].WebhookID)
+	}
+
+	// Disabled subscriptions are not notified of new transactions
+	transfer("external", "account2", 5)
+	deliveries, err = repo.WebhookDeliveries(context.Background(), entities.WebhookDeliveryQuery{WebhookID: other.ID, Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+
+	_, err = repo.DisableWebhook(context.Background(), other.ID+1)
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
@@ -2,17 +2,23 @@ package redisx
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/julioc98/ledger/domain"
 	"github.com/julioc98/ledger/domain/entities"
 	"github.com/julioc98/ledger/gateway/pg"
 	"github.com/redis/go-redis/v9"
 )
 
 const (
-	cachePrefix = "ledger:"
-	cacheTTL    = 5 * time.Second // Cache expiration time
+	cachePrefix    = "ledger:"
+	cacheTTL       = 5 * time.Second // Cache expiration time
+	idempotencyTTL = 24 * time.Hour  // How long replays are answered from the cache
 )
 
 // AccountRedisRepositoryDecorator is a repository that uses Redis as a database.
@@ -26,9 +32,9 @@ func NewAccountRedisRepositoryDecorator(redisClient *redis.Client, dbRepo *pg.Ac
 	return &AccountRedisRepositoryDecorator{redisClient, dbRepo}
 }
 
-func (r *AccountRedisRepositoryDecorator) TransfersHistory(ctx context.Context, account string) ([]entities.Entry, error) {
-	// Cache key for the transfer history
-	cacheKey := cachePrefix + "transfers:" + account
+func (r *AccountRedisRepositoryDecorator) TransfersHistory(ctx context.Context, query entities.HistoryQuery) ([]entities.Entry, error) {
+	// Cache key for the page of the transfer history
+	cacheKey := historyCacheKey(query)
 
 	// Try to get the transfer history from the Redis cache
 	cacheValue, err := r.redisClient.Get(ctx, cacheKey).Result()
@@ -41,7 +47,7 @@ func (r *AccountRedisRepositoryDecorator) TransfersHistory(ctx context.Context,
 	}
 
 	// Cache miss, get the transfer history from the database
-	history, err := r.dbRepo.TransfersHistory(ctx, account)
+	history, err := r.dbRepo.TransfersHistory(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -61,3 +67,95 @@ func (r *AccountRedisRepositoryDecorator) TransfersHistory(ctx context.Context,
 
 	return history, nil
 }
+
+// historyCacheKey returns a cache key unique to the account and every
+// parameter of the query, so each filtered page is cached on its own.
+func historyCacheKey(query entities.HistoryQuery) string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+
+	var after string
+	if query.After != nil {
+		after = query.After.String()
+	}
+
+	params := strings.Join([]string{
+		formatTime(query.From),
+		formatTime(query.To),
+		string(query.Direction),
+		strconv.FormatInt(query.MinAmount, 10),
+		strconv.FormatInt(query.MaxAmount, 10),
+		after,
+		strconv.Itoa(query.Limit),
+	}, "|")
+	sum := sha256.Sum256([]byte(params))
+
+	return cachePrefix + "transfers:" + query.Account + ":" + hex.EncodeToString(sum[:])
+}
+
+// Transfer books the entries through idempotent.
+func (r *AccountRedisRepositoryDecorator) Transfer(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	return r.idempotent(ctx, entries.JournalEntry(), func() (int64, error) {
+		return r.dbRepo.Transfer(ctx, entries)
+	})
+}
+
+// TransferWithFundsCheck books the entries through idempotent.
+func (r *AccountRedisRepositoryDecorator) TransferWithFundsCheck(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	return r.idempotent(ctx, entries.JournalEntry(), func() (int64, error) {
+		return r.dbRepo.TransferWithFundsCheck(ctx, entries)
+	})
+}
+
+// PendingTransfer books the pending entries through idempotent.
+func (r *AccountRedisRepositoryDecorator) PendingTransfer(ctx context.Context, entries entities.DoubleEntry) (int64, error) {
+	je := entries.JournalEntry()
+	je.Pending = true
+
+	return r.idempotent(ctx, je, func() (int64, error) {
+		return r.dbRepo.PendingTransfer(ctx, entries)
+	})
+}
+
+// idempotent answers replays of the idempotency key of je from the cache and
+// calls book for everything else, as the database remains the source of truth.
+func (r *AccountRedisRepositoryDecorator) idempotent(ctx context.Context, je entities.JournalEntry, book func() (int64, error)) (int64, error) {
+	if je.IdempotencyKey == "" {
+		return book()
+	}
+
+	// Cache key for the idempotency key, holding "<fingerprint>:<transaction ID>"
+	cacheKey := cachePrefix + "idempotency:" + je.IdempotencyKey
+	fingerprint := je.Fingerprint()
+
+	// Try to answer the replay from the Redis cache
+	cacheValue, err := r.redisClient.Get(ctx, cacheKey).Result()
+	if err == nil {
+		// Cache hit
+		cachedFingerprint, id, ok := strings.Cut(cacheValue, ":")
+		if ok {
+			if cachedFingerprint != fingerprint {
+				return 0, domain.ErrIdempotencyKeyReused
+			}
+			if transactionID, err := strconv.ParseInt(id, 10, 64); err == nil {
+				return transactionID, nil
+			}
+		}
+	}
+
+	// Cache miss, book the entries in the database
+	transactionID, err := book()
+	if err != nil {
+		return 0, err
+	}
+
+	// The cache is only a fast path, so failing to fill it is not an error
+	cacheValue = fingerprint + ":" + strconv.FormatInt(transactionID, 10)
+	r.redisClient.Set(ctx, cacheKey, cacheValue, idempotencyTTL)
+
+	return transactionID, nil
+}
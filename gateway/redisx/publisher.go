@@ -0,0 +1,35 @@
+package redisx
+
+import (
+	"context"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/redis/go-redis/v9"
+)
+
+// StreamPublisher publishes ledger events to a Redis stream, one stream entry
+// per event with its id, type, transaction_id and JSON payload as fields.
+// Consumers read the stream with consumer groups and must skip the events
+// whose id they already handled, as relays may publish an event twice.
+type StreamPublisher struct {
+	redisClient *redis.Client
+	stream      string
+}
+
+// NewStreamPublisher returns a new StreamPublisher appending to stream.
+func NewStreamPublisher(redisClient *redis.Client, stream string) *StreamPublisher {
+	return &StreamPublisher{redisClient, stream}
+}
+
+// Publish appends the event to the stream.
+func (p *StreamPublisher) Publish(ctx context.Context, event entities.Event) error {
+	return p.redisClient.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		Values: map[string]any{
+			"id":             event.ID,
+			"type":           string(event.Type),
+			"transaction_id": event.TransactionID,
+			"payload":        event.Payload,
+		},
+	}).Err()
+}
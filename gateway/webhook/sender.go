@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/julioc98/ledger/domain/entities"
+)
+
+// Headers set on every delivery. Signature is "sha256=" followed by
+// entities.SignWebhook of the Timestamp header and the body.
+const (
+	HeaderEvent     = "Ledger-Event"
+	HeaderDelivery  = "Ledger-Delivery"
+	HeaderTimestamp = "Ledger-Timestamp"
+	HeaderSignature = "Ledger-Signature"
+)
+
+// HTTPSender posts webhook deliveries as signed JSON documents.
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender returns a new HTTPSender. The client's timeout bounds each
+// attempt.
+func NewHTTPSender(client *http.Client) *HTTPSender {
+	return &HTTPSender{client}
+}
+
+// Send posts the delivery's body to its URL. Responses other than 2xx are
+// failures, and so are requests without response, reported with a zero
+// status code.
+func (s *HTTPSender) Send(ctx context.Context, delivery entities.WebhookDelivery) (int, error) {
+	body, err := delivery.Body()
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.EventType))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, "sha256="+entities.SignWebhook(delivery.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,89 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/julioc98/ledger/domain/entities"
+	"github.com/julioc98/ledger/gateway/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	delivery := entities.WebhookDelivery{
+		ID:        7,
+		EventID:   3,
+		EventType: entities.EventTransactionPosted,
+		Account:   "account1",
+		Secret:    "whsec",
+		Payload:   []byte(`{"ID":1}`),
+	}
+
+	tests := []struct {
+		name           string
+		status         int
+		wantStatusCode int
+		wantErr        bool
+	}{
+		{name: "should succeed on 2xx responses", status: http.StatusNoContent, wantStatusCode: http.StatusNoContent},
+		{name: "should fail on other responses", status: http.StatusInternalServerError, wantStatusCode: http.StatusInternalServerError, wantErr: true},
+		{name: "should fail on redirections", status: http.StatusMultipleChoices, wantStatusCode: http.StatusMultipleChoices, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "TransactionPosted", r.Header.Get(webhook.HeaderEvent))
+				assert.Equal(t, "7", r.Header.Get(webhook.HeaderDelivery))
+
+				timestamp, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+				assert.NoError(t, err)
+				assert.Equal(t, "sha256="+entities.SignWebhook("whsec", timestamp, body), r.Header.Get(webhook.HeaderSignature))
+
+				var got map[string]any
+				assert.NoError(t, json.Unmarshal(body, &got))
+				assert.Equal(t, map[string]any{
+					"id":          float64(7),
+					"event_id":    float64(3),
+					"type":        "TransactionPosted",
+					"account":     "account1",
+					"transaction": map[string]any{"ID": float64(1)},
+				}, got)
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			d := delivery
+			d.URL = server.URL
+			statusCode, err := webhook.NewHTTPSender(server.Client()).Send(context.Background(), d)
+			assert.Equal(t, tt.wantStatusCode, statusCode)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("should fail without a response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		d := delivery
+		d.URL = server.URL
+		statusCode, err := webhook.NewHTTPSender(server.Client()).Send(context.Background(), d)
+		assert.Zero(t, statusCode)
+		assert.Error(t, err)
+	})
+}